@@ -2,6 +2,7 @@ module github.com/calebamiles/keps
 
 require (
 	github.com/go-yaml/yaml v2.1.0+incompatible
+	github.com/gofrs/flock v0.7.0
 	github.com/google/uuid v1.0.0
 	github.com/hashicorp/go-multierror v1.0.0
 	github.com/inconshreveable/mousetrap v1.0.0 // indirect
@@ -10,6 +11,7 @@ require (
 	github.com/sirupsen/logrus v1.1.0
 	github.com/spf13/cobra v0.0.3
 	github.com/spf13/pflag v1.0.3 // indirect
+	golang.org/x/crypto v0.0.0-20180904163835-0709b304e793
 	golang.org/x/net v0.0.0-20181220203305-927f97764cc3 // indirect
 	golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4 // indirect
 	golang.org/x/sys v0.0.0-20190102155601-82a175fd1598 // indirect
@@ -16,6 +16,7 @@ import (
 	"github.com/calebamiles/keps/helpers/convert/internal/metadata"
 	"github.com/calebamiles/keps/helpers/convert/internal/render"
 	"github.com/calebamiles/keps/pkg/keps"
+	kepmetadata "github.com/calebamiles/keps/pkg/keps/metadata"
 	"github.com/calebamiles/keps/pkg/keps/skeleton"
 )
 
@@ -40,6 +41,8 @@ func ToCurrent(outputDir string, kepLocation string) (string, error) {
 		return "", err
 	}
 
+	newMetadata.SchemaVersionField = kepmetadata.SchemaVersion
+
 	newMetadata.TitleField = oldMetadata.Title         // assume this will be set
 	newMetadata.AuthorsField = oldMetadata.Authors     // assume this will be set
 	newMetadata.StateField = oldMetadata.Status        // assume this will be set
@@ -154,7 +157,7 @@ func ToCurrent(outputDir string, kepLocation string) (string, error) {
 		return "", err
 	}
 
-	k, err := keps.Open(convertedLocation)
+	k, err := keps.Open(outputDir, convertedLocation)
 	if err != nil {
 		return "", err
 	}
@@ -5,6 +5,7 @@ import (
 )
 
 type New struct {
+	SchemaVersionField     int       `yaml:"schema_version"`
 	AuthorsField           []string  `yaml:"authors,omitempty"`
 	TitleField             string    `yaml:"title,omitempty"`
 	ShortIDField           *int      `yaml:"kep_number,omitempty"`
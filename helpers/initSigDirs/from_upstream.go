@@ -19,7 +19,7 @@ func main() {
 	initLocation := os.Args[1]
 
 	var errs *multierror.Error
-	for _, sigName := range sigs.All() {
+	for _, sigName := range sigs.Groups() {
 		errs = multierror.Append(errs, os.MkdirAll(filepath.Join(initLocation, sigName), os.ModePerm))
 	}
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"io/ioutil"
@@ -10,7 +11,10 @@ import (
 )
 
 func main() {
-	sl, err := sigs.FetchUpstreamList()
+	sigsYaml := flag.String("sigs-yaml", "", "render from a local copy of sigs.yaml rather than downloading it")
+	flag.Parse()
+
+	sl, err := sigs.LoadList(*sigsYaml)
 	if err != nil {
 		log.Fatalf("fetching upstream SIG information: %s", err)
 	}
@@ -2,29 +2,155 @@ package sigs
 
 import (
 	"net/http"
+	"net/url"
 	"io/ioutil"
 	"fmt"
+	"log"
+	"strings"
 
 	"github.com/go-yaml/yaml"
+
+	"github.com/calebamiles/keps/pkg/keps/metadata"
 )
 
 func FetchUpstreamList() (*upstreamSIGList, error) {
-	resp, err := http.Get(UpstreamSIGListURL)
-	defer resp.Body.Close()
+	respBytes, err := download(UpstreamSIGListURL)
 	if err != nil {
 		return nil, fmt.Errorf("downloading SIG info: %s", err)
 	}
 
-	respBytes, err := ioutil.ReadAll(resp.Body)
+	return parseList(respBytes)
+}
+
+// LoadList reads SIG information from a local copy of sigs.yaml at p, or
+// downloads it from upstream when p is empty. The OWNERS files linked from a
+// local copy are still downloaded
+func LoadList(p string) (*upstreamSIGList, error) {
+	if p == "" {
+		return FetchUpstreamList()
+	}
+
+	b, err := ioutil.ReadFile(p)
 	if err != nil {
-		return nil, fmt.Errorf("reading downloaded SIG info: %s", err)
+		return nil, fmt.Errorf("reading SIG info: %s", err)
 	}
 
+	return parseList(b)
+}
+
+func parseList(b []byte) (*upstreamSIGList, error) {
 	sl := &upstreamSIGList{}
-	err = yaml.Unmarshal(respBytes, sl)
+	err := yaml.Unmarshal(b, sl)
 	if err != nil {
-		return nil, fmt.Errorf("unmarshalling downloaded SIG info: %s", err)
+		return nil, fmt.Errorf("unmarshalling SIG info: %s", err)
+	}
+
+	aliases := map[string]map[string][]string{}
+	for _, groups := range [][]upstreamSIGEntry{sl.SIGs, sl.WorkingGroups, sl.UserGroups, sl.Committees} {
+		for i := range groups {
+			for j := range groups[i].Subprojects {
+				collectOwners(&groups[i].Subprojects[j], aliases)
+			}
+		}
 	}
 
 	return sl, nil
 }
+
+// collectOwners reads the approvers and reviewers from each OWNERS file of a
+// subproject, expanding the aliases of its repository and dropping anything
+// which is not a GitHub handle. OWNERS files which cannot be read are skipped
+// rather than failing the whole list
+func collectOwners(sp *upstreamSubprojectEntry, aliases map[string]map[string][]string) {
+	for _, ownersURL := range sp.Owners {
+		ownersBytes, err := download(ownersURL)
+		if err != nil {
+			log.Printf("skipping OWNERS for subproject %s: %s", sp.Name, err)
+			continue
+		}
+
+		owners := &upstreamOwnersFile{}
+		err = yaml.Unmarshal(ownersBytes, owners)
+		if err != nil {
+			log.Printf("skipping OWNERS for subproject %s: %s", sp.Name, err)
+			continue
+		}
+
+		repoAliases := aliasesFor(ownersURL, aliases)
+
+		sp.Approvers = append(sp.Approvers, expandAliases(owners.Approvers, repoAliases)...)
+		sp.Reviewers = append(sp.Reviewers, expandAliases(owners.Reviewers, repoAliases)...)
+	}
+}
+
+// aliasesFor returns the aliases defined in the OWNERS_ALIASES at the root of
+// the repository containing the OWNERS file at ownersURL, such as
+// https://raw.githubusercontent.com/owner/repo/ref/path/OWNERS
+func aliasesFor(ownersURL string, aliases map[string]map[string][]string) map[string][]string {
+	u, err := url.Parse(ownersURL)
+	if err != nil {
+		return nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 4)
+	if len(parts) < 4 {
+		return nil
+	}
+
+	u.Path = "/" + strings.Join(append(parts[:3], "OWNERS_ALIASES"), "/")
+	aliasesURL := u.String()
+
+	if found, ok := aliases[aliasesURL]; ok {
+		return found
+	}
+
+	parsed := &struct {
+		Aliases map[string][]string `yaml:"aliases"`
+	}{}
+
+	b, err := download(aliasesURL)
+	if err == nil {
+		err = yaml.Unmarshal(b, parsed)
+	}
+
+	if err != nil {
+		log.Printf("no OWNERS_ALIASES at %s: %s", aliasesURL, err)
+	}
+
+	aliases[aliasesURL] = parsed.Aliases
+
+	return parsed.Aliases
+}
+
+func expandAliases(entries []string, aliases map[string][]string) []string {
+	expanded := []string{}
+	for _, entry := range entries {
+		members, isAlias := aliases[entry]
+		if !isAlias {
+			members = []string{entry}
+		}
+
+		for _, m := range members {
+			if metadata.IsHandle(m) {
+				expanded = append(expanded, m)
+			}
+		}
+	}
+
+	return expanded
+}
+
+func download(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s returned %s", url, resp.Status)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
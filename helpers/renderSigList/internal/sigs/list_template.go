@@ -36,21 +36,21 @@ var InfoForPath = map[string]*PathInfo{
 		KubernetesWide: true,
 	},
 
-	{{ with .SIGs }}
+	{{ with .Groups }}
                 {{ range . }}
-			"{{ joinPath (canonicalSIGName .Name) }}": &PathInfo{
-								OwningSIG: "{{ canonicalSIGName .Name }}",
+			"{{ joinPath .Dir }}": &PathInfo{
+								OwningSIG: "{{ .Dir }}",
 								SIGWide: true,
 							},
                 {{ end }}
 	{{ end }}
 
-	{{ with .SIGs }}
-		{{ range $_, $SIG := . }}
+	{{ with .Groups }}
+		{{ range $_, $Group := . }}
 			{{ with .Subprojects }}
 				{{ range $_, $Subproject := . }}
-					"{{ joinPath (canonicalSIGName $SIG.Name) (canonicalName $Subproject.Name) }}": &PathInfo {
-							OwningSIG: "{{ canonicalSIGName $SIG.Name }}",
+					"{{ joinPath $Group.Dir (canonicalName $Subproject.Name) }}": &PathInfo {
+							OwningSIG: "{{ $Group.Dir }}",
 							Subproject: "{{ canonicalName $Subproject.Name }}",
 						 },
 				{{ end }}
@@ -62,17 +62,17 @@ var InfoForPath = map[string]*PathInfo{
 // SIGSet is the set of SIGs generated by KEP tooling helpers
 // DO NOT EDIT BY HAND
 var SIGSet = map[string]bool{
-        {{ with .SIGs }}
+        {{ with .SIGGroups }}
                 {{ range . }}
-                        "{{canonicalSIGName .Name }}": true,
+                        "{{ .Dir }}": true,
                 {{ end }}
         {{ end }}
 }
 
-// FlattedSubprojectSet is the flattened collection of SIG subprojects
+// FlattedSubprojectSet is the flattened collection of subprojects of groups of every kind
 // DO NOT EDIT BY HAND
 var FlattenedSubprojectSet = map[string]bool{
-         {{ with .SIGs }}
+         {{ with .Groups }}
                 {{ range . }}
                 	{{ with .Subprojects }}
                         	{{ range . }}
@@ -83,12 +83,12 @@ var FlattenedSubprojectSet = map[string]bool{
         {{ end }}
 }
 
-// SIGSubprojectMapping groups subprojects by their owning SIG
+// SIGSubprojectMapping groups subprojects by their owning group, of any kind
 // DO NOT EDIT BY HAND
 var SIGSubprojectMapping = map[string]map[string]bool{
-         {{ with .SIGs }}
+         {{ with .Groups }}
                 {{ range . }}
-                        "{{ canonicalSIGName .Name }}": map[string]bool {
+                        "{{ .Dir }}": map[string]bool {
                                 {{ with .Subprojects }}
                                         {{ range . }}
                                                 "{{ canonicalName .Name }}": true,
@@ -99,11 +99,79 @@ var SIGSubprojectMapping = map[string]map[string]bool{
         {{ end }}
 }
 
+// Leadership is the leadership of a SIG listed in sigs.yaml
+type Leadership struct {
+	Chairs []string
+	TechnicalLeads []string
+}
+
+// SubprojectOwners are the approvers and reviewers from the OWNERS files of a subproject
+type SubprojectOwners struct {
+	Approvers []string
+	Reviewers []string
+}
+
+// LeadershipForSIG maps groups of every kind to their chairs and technical leads
+// DO NOT EDIT BY HAND
+var LeadershipForSIG = map[string]*Leadership{
+        {{ with .Groups }}
+                {{ range . }}
+                        "{{ .Dir }}": &Leadership{
+                                Chairs: []string{ {{ range .Leadership.Chairs }}"{{ .GitHub }}", {{ end }} },
+                                TechnicalLeads: []string{ {{ range .Leadership.TechnicalLeads }}"{{ .GitHub }}", {{ end }} },
+                        },
+                {{ end }}
+        {{ end }}
+}
+
+// OwnersForSubproject maps groups of every kind to the OWNERS of each of their subprojects
+// DO NOT EDIT BY HAND
+var OwnersForSubproject = map[string]map[string]*SubprojectOwners{
+         {{ with .Groups }}
+                {{ range . }}
+                        "{{ .Dir }}": map[string]*SubprojectOwners {
+                                {{ with .Subprojects }}
+                                        {{ range . }}
+                                                "{{ canonicalName .Name }}": &SubprojectOwners{
+                                                        Approvers: []string{ {{ range .Approvers }}"{{ . }}", {{ end }} },
+                                                        Reviewers: []string{ {{ range .Reviewers }}"{{ . }}", {{ end }} },
+                                                },
+                                        {{ end }}
+                                {{ end }}
+                        },
+                {{ end }}
+        {{ end }}
+}
+
 var SIGList = []string{
-        {{ with .SIGs }}
+        {{ with .SIGGroups }}
+                {{ range . }}
+                        "{{ .Dir }}",
+                {{ end }}
+        {{ end }}
+}
+
+var WorkingGroupList = []string{
+        {{ with .WorkingGroupGroups }}
+                {{ range . }}
+                        "{{ .Dir }}",
+                {{ end }}
+        {{ end }}
+}
+
+var UserGroupList = []string{
+        {{ with .UserGroupGroups }}
+                {{ range . }}
+                        "{{ .Dir }}",
+                {{ end }}
+        {{ end }}
+}
+
+var CommitteeList = []string{
+        {{ with .CommitteeGroups }}
                 {{ range . }}
-                        "{{ canonicalSIGName .Name }}",
+                        "{{ .Dir }}",
                 {{ end }}
         {{ end }}
 }
-`
+`
\ No newline at end of file
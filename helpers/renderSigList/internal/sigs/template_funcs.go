@@ -14,6 +14,18 @@ func canonicalSIGName(raw string) string {
 	return "sig-" + canonicalName(raw)
 }
 
+func canonicalWGName(raw string) string {
+	return "wg-" + canonicalName(raw)
+}
+
+func canonicalUGName(raw string) string {
+	return "ug-" + canonicalName(raw)
+}
+
+func canonicalCommitteeName(raw string) string {
+	return "committee-" + canonicalName(raw)
+}
+
 func joinPath(elem ...string) string {
 	return filepath.Join(elem...)
 }
@@ -21,5 +33,8 @@ func joinPath(elem ...string) string {
 var upstreamListTemplateFuncs = template.FuncMap{
 		"canonicalName": canonicalName,
 		"canonicalSIGName": canonicalSIGName,
+		"canonicalWGName": canonicalWGName,
+		"canonicalUGName": canonicalUGName,
+		"canonicalCommitteeName": canonicalCommitteeName,
 		"joinPath": joinPath,
 }
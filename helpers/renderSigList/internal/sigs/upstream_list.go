@@ -2,15 +2,96 @@ package sigs
 
 type upstreamSIGList struct {
 	SIGs []upstreamSIGEntry `yaml:"sigs"`
+	WorkingGroups []upstreamSIGEntry `yaml:"workinggroups"`
+	UserGroups []upstreamSIGEntry `yaml:"usergroups"`
+	Committees []upstreamSIGEntry `yaml:"committees"`
+}
+
+// upstreamGroup is a SIG, working group, user group or committee along with
+// the name of its directory
+type upstreamGroup struct {
+	upstreamSIGEntry
+	Dir string
+}
+
+// dirOr returns the directory listed for the group, or canonical when none is listed
+func (e upstreamSIGEntry) dirOr(canonical string) string {
+	if e.ListedDir != "" {
+		return e.ListedDir
+	}
+
+	return canonical
+}
+
+func withDirs(entries []upstreamSIGEntry, canonical func(string) string) []upstreamGroup {
+	groups := []upstreamGroup{}
+	for _, e := range entries {
+		groups = append(groups, upstreamGroup{upstreamSIGEntry: e, Dir: e.dirOr(canonical(e.Name))})
+	}
+
+	return groups
+}
+
+// SIGGroups returns the SIGs along with their directories
+func (l *upstreamSIGList) SIGGroups() []upstreamGroup {
+	return withDirs(l.SIGs, canonicalSIGName)
+}
+
+// WorkingGroupGroups returns the working groups along with their directories
+func (l *upstreamSIGList) WorkingGroupGroups() []upstreamGroup {
+	return withDirs(l.WorkingGroups, canonicalWGName)
+}
+
+// UserGroupGroups returns the user groups along with their directories
+func (l *upstreamSIGList) UserGroupGroups() []upstreamGroup {
+	return withDirs(l.UserGroups, canonicalUGName)
+}
+
+// CommitteeGroups returns the committees along with their directories
+func (l *upstreamSIGList) CommitteeGroups() []upstreamGroup {
+	return withDirs(l.Committees, canonicalCommitteeName)
+}
+
+// Groups returns every group of every kind, SIGs first
+func (l *upstreamSIGList) Groups() []upstreamGroup {
+	groups := l.SIGGroups()
+	groups = append(groups, l.WorkingGroupGroups()...)
+	groups = append(groups, l.UserGroupGroups()...)
+	groups = append(groups, l.CommitteeGroups()...)
+
+	return groups
 }
 
 type upstreamSIGEntry struct {
-	Name string `yaml:"name"`// we actually want to look at what the SIG is called on disk
+	Name string `yaml:"name"`
+	ListedDir string `yaml:"dir"` // what the group is called on disk
+	Leadership upstreamLeadership `yaml:"leadership"`
 	Subprojects []upstreamSubprojectEntry `yaml:"subprojects"`
 }
 
+type upstreamLeadership struct {
+	Chairs []upstreamLeader `yaml:"chairs"`
+	TechnicalLeads []upstreamLeader `yaml:"tech_leads"`
+}
+
+type upstreamLeader struct {
+	GitHub string `yaml:"github"`
+	Name string `yaml:"name"`
+}
+
 type upstreamSubprojectEntry struct {
 	Name string `yaml:"name"`
+	Owners []string `yaml:"owners"` // links to OWNERS files
+
+	// collected from the OWNERS files listed in Owners
+	Approvers []string `yaml:"-"`
+	Reviewers []string `yaml:"-"`
+}
+
+// upstreamOwnersFile is the subset of an OWNERS file used by KEP tooling
+type upstreamOwnersFile struct {
+	Approvers []string `yaml:"approvers"`
+	Reviewers []string `yaml:"reviewers"`
 }
 
 const UpstreamSIGListURL = "https://raw.githubusercontent.com/kubernetes/community/master/sigs.yaml"
@@ -9,6 +9,11 @@ import (
 	"github.com/calebamiles/keps/pkg/workflow"
 )
 
+var (
+	initParticipatingSIGs []string
+	initSubprojects       []string
+)
+
 // initCmd represents the init command
 var initCmd = &cobra.Command{
 	Use:   "init",
@@ -35,29 +40,33 @@ last path element. For example:
   will create a KEP titled "Dynamic Kubelet Config" at
   content/sig-node/kubelet/dynamic-kubelet-config
 
-init will create a KEP directory containing templated files for the required KEP sections.`,
+init will create a KEP directory containing templated files for the required KEP sections.
+SIGs collaborating on the KEP and any other subprojects it affects can be given with
+--participating-sigs and --subprojects, for example:
+
+	kep init --participating-sigs sig-storage --subprojects kubelet content/sig-node/device-plugins`,
 	Args: cobra.ExactArgs(1), // accept just one argument, target location for content
 	RunE: func(cmd *cobra.Command, args []string) error {
 		targetPath := args[0] // we have a validator ensuring we will have exactly one positional arg
 
-		contentRoot, err := settings.FindContentRoot()
+		contentRoot, err := settings.FindContentRoot(userSettings)
 		if err != nil {
 			return err
 		}
 
 		// save it now to avoid the expensive look everywhere under $HOME next time
-		err = settings.SaveContentRoot(contentRoot)
+		err = settings.SaveContentRoot(userSettings, contentRoot)
 		if err != nil {
 			return err
 		}
 
-		principal, err := settings.FindPrincipal()
+		principal, err := settings.FindPrincipal(userSettings)
 		if err != nil {
 			return err
 		}
 
 		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
-		kepContentDir, err := workflow.Init(runtimeSettings)
+		kepContentDir, err := workflow.InitWithRouting(runtimeSettings, initParticipatingSIGs, initSubprojects)
 		if err != nil {
 			return err
 		}
@@ -66,3 +75,8 @@ init will create a KEP directory containing templated files for the required KEP
 		return nil
 	},
 }
+
+func init() {
+	initCmd.Flags().StringSliceVar(&initParticipatingSIGs, "participating-sigs", nil, "SIGs, working groups, user groups or committees collaborating on the KEP")
+	initCmd.Flags().StringSliceVar(&initSubprojects, "subprojects", nil, "subprojects affected by the KEP in addition to the one it is created in")
+}
@@ -19,38 +19,98 @@ an iterative process for managing "Change at the Speed of Trust". Proposing an
 enhancement for SIG reviewal frames a problem or gap for SIG consideration and
 allows a SIG to decide whether SIG resources should be dedicated to shepherd the
 enhancement to completion. The propose command serves as a preflight checklist
-to ensure SIGs spend their limited bandwidth reviewing high quality proposals.`,
+to ensure SIGs spend their limited bandwidth reviewing high quality proposals.
+
+A pull request is only opened on GitHub when the user settings configure a
+github_token_path, or a github_token_source such as "auto" which searches
+GITHUB_TOKEN, the token path, the gh CLI and git credential helpers in turn.
+Otherwise the KEP is only checked and changed locally.`,
 	Args: cobra.ExactArgs(1), // accept just one argument, location of KEP
 	RunE: func(cmd *cobra.Command, args []string) error {
 		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
 
-		contentRoot, err := settings.FindContentRoot()
+		contentRoot, err := settings.FindContentRoot(userSettings)
 		if err != nil {
 			return err
 		}
 
 		// save it now to avoid the expensive look everywhere under $HOME next time
-		err = settings.SaveContentRoot(contentRoot)
+		err = settings.SaveContentRoot(userSettings, contentRoot)
+		if err != nil {
+			return err
+		}
+
+		principal, err := settings.FindPrincipal(userSettings)
 		if err != nil {
 			return err
 		}
 
-		principal, err := settings.FindPrincipal()
+		runtimeSettings, err := reviewRuntime(contentRoot, targetPath, principal)
 		if err != nil {
 			return err
 		}
 
-		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
-		err = workflow.Propose(runtimeSettings)
+		reviewLocation, err := workflow.Propose(runtimeSettings)
 		if err != nil {
 			return err
 		}
 
+		if reviewLocation != "" {
+			fmt.Printf("KEP proposed for SIG review at: %s\n", reviewLocation)
+			return printWarnings(runtimeSettings)
+		}
+
 		fmt.Println("KEP is ready for proposal!")
-		return nil
+		return printWarnings(runtimeSettings)
 	},
 }
 
+// reviewRuntime returns a Runtime submitting changes to a KEP for review as
+// configured in the user settings, either as a GitHub pull request or as
+// a patch series, or only changing the KEP locally when neither is configured
+func reviewRuntime(contentRoot string, targetPath string, principal string) (settings.Runtime, error) {
+	author, err := settings.FindAuthor(userSettings, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	localOptions, err := settings.FindLocalOptions(userSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	if localOptions != nil {
+		return settings.NewLocalRuntime(contentRoot, targetPath, principal, author, *localOptions), nil
+	}
+
+	token, err := settings.FindToken(userSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	githubOptions, err := settings.FindGitHubOptions(userSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings.NewRuntimeWithOptions(contentRoot, targetPath, principal, token, githubOptions, author), nil
+}
+
+// printWarnings prints the problems less serious than errors found by checking
+// the KEP, which never prevent changing it
+func printWarnings(runtime settings.Runtime) error {
+	warnings, err := workflow.Warnings(runtime)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range warnings {
+		fmt.Printf("%s: %s\n", w.Severity, w.Message)
+	}
+
+	return nil
+}
+
 // TODO maybe kill off these init() functions
 // the best worst place for them might be inside of cmd.Execute() which lives inside of root.go
 func init() {
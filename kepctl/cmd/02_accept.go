@@ -26,29 +26,38 @@ teaching, and operations guides for approval before implementation`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
 
-		contentRoot, err := settings.FindContentRoot()
+		contentRoot, err := settings.FindContentRoot(userSettings)
 		if err != nil {
 			return err
 		}
 
 		// save it now to avoid the expensive look everywhere under $HOME next time
-		err = settings.SaveContentRoot(contentRoot)
+		err = settings.SaveContentRoot(userSettings, contentRoot)
 		if err != nil {
 			return err
 		}
 
-		principal, err := settings.FindPrincipal()
+		principal, err := settings.FindPrincipal(userSettings)
 		if err != nil {
 			return err
 		}
 
-		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
-		err = workflow.Accept(runtimeSettings)
+		runtimeSettings, err := reviewRuntime(contentRoot, targetPath, principal)
 		if err != nil {
 			return err
 		}
 
+		reviewLocation, err := workflow.Accept(runtimeSettings)
+		if err != nil {
+			return err
+		}
+
+		if reviewLocation != "" {
+			fmt.Printf("successfully marked KEP as accepted!\nreview at: %s\n", reviewLocation)
+			return printWarnings(runtimeSettings)
+		}
+
 		fmt.Println("successfully marked KEP as accepted!")
-		return nil
+		return printWarnings(runtimeSettings)
 	},
 }
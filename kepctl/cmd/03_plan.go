@@ -29,29 +29,38 @@ expected and will be checked by the KEP reviewers and approvers.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
 
-		contentRoot, err := settings.FindContentRoot()
+		contentRoot, err := settings.FindContentRoot(userSettings)
 		if err != nil {
 			return err
 		}
 
 		// save it now to avoid the expensive look everywhere under $HOME next time
-		err = settings.SaveContentRoot(contentRoot)
+		err = settings.SaveContentRoot(userSettings, contentRoot)
 		if err != nil {
 			return err
 		}
 
-		principal, err := settings.FindPrincipal()
+		principal, err := settings.FindPrincipal(userSettings)
 		if err != nil {
 			return err
 		}
 
-		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
-		err = workflow.Plan(runtimeSettings)
+		runtimeSettings, err := reviewRuntime(contentRoot, targetPath, principal)
 		if err != nil {
 			return err
 		}
 
+		reviewLocation, err := workflow.Plan(runtimeSettings)
+		if err != nil {
+			return err
+		}
+
+		if reviewLocation != "" {
+			fmt.Printf("successfully started planning for KEP!\nreview at: %s\n", reviewLocation)
+			return printWarnings(runtimeSettings)
+		}
+
 		fmt.Println("successfully started planning for KEP!")
-		return nil
+		return printWarnings(runtimeSettings)
 	},
 }
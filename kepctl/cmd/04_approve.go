@@ -24,29 +24,38 @@ implementation.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
 
-		contentRoot, err := settings.FindContentRoot()
+		contentRoot, err := settings.FindContentRoot(userSettings)
 		if err != nil {
 			return err
 		}
 
 		// save it now to avoid the expensive look everywhere under $HOME next time
-		err = settings.SaveContentRoot(contentRoot)
+		err = settings.SaveContentRoot(userSettings, contentRoot)
 		if err != nil {
 			return err
 		}
 
-		principal, err := settings.FindPrincipal()
+		principal, err := settings.FindPrincipal(userSettings)
 		if err != nil {
 			return err
 		}
 
-		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
-		err = workflow.Approve(runtimeSettings)
+		runtimeSettings, err := reviewRuntime(contentRoot, targetPath, principal)
 		if err != nil {
 			return err
 		}
 
+		reviewLocation, err := workflow.Approve(runtimeSettings)
+		if err != nil {
+			return err
+		}
+
+		if reviewLocation != "" {
+			fmt.Printf("sucessfully marked KEP as approved!\nreview at: %s\n", reviewLocation)
+			return printWarnings(runtimeSettings)
+		}
+
 		fmt.Println("sucessfully marked KEP as approved!")
-		return nil
+		return printWarnings(runtimeSettings)
 	},
 }
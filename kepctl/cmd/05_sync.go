@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/changes"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var syncUpdateState bool
+
+// syncCmd represents the sync command
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "update a KEP with the status of its pull requests",
+	Long: `
+Sync queries GitHub for the status of each open pull request associated with a
+KEP, such as the pull request opened by propose. Pull requests which have been
+merged or closed are recorded in the event log kept in the KEP metadata. With
+--update-state a merged accept pull request also marks the KEP "implementable"
+and a merged implementation pull request, see track, marks an implementable KEP
+"implemented"`,
+	Args: cobra.ExactArgs(1), // accept just one argument, location of KEP
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		principal, err := settings.FindPrincipal(userSettings)
+		if err != nil {
+			return err
+		}
+
+		token, err := settings.FindToken(userSettings)
+		if err != nil {
+			return err
+		}
+
+		githubOptions, err := settings.FindGitHubOptions(userSettings)
+		if err != nil {
+			return err
+		}
+
+		runtimeSettings := settings.NewRuntimeWithOptions(contentRoot, targetPath, principal, token, githubOptions, changes.Author{})
+
+		events, err := workflow.Sync(runtimeSettings, syncUpdateState)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			fmt.Println("KEP pull requests are up to date")
+			return nil
+		}
+
+		for _, event := range events {
+			fmt.Println(event.Description)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	syncCmd.Flags().BoolVar(&syncUpdateState, "update-state", false, "move the KEP to the state implied by merged pull requests")
+}
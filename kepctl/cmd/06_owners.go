@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var ownersAssign bool
+
+// ownersCmd represents the owners command
+var ownersCmd = &cobra.Command{
+	Use:   "owners",
+	Short: "suggest reviewers and approvers for a KEP from SIG OWNERS data",
+	Long: `
+Owners suggests reviewers and approvers for a KEP from the leadership of its
+owning SIG and the OWNERS files of its affected subprojects, as listed in
+sigs.yaml. Approvers are the subproject approvers, or the SIG chairs and
+technical leads for KEPs which do not affect a subproject. Reviewers are the
+subproject reviewers along with the SIG technical leads. KEP authors are never
+suggested. With --assign the suggestions are added to the KEP metadata`,
+	Args: cobra.ExactArgs(1), // accept just one argument, location of KEP
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		principal, err := settings.FindPrincipal(userSettings)
+		if err != nil {
+			return err
+		}
+
+		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
+
+		suggest := workflow.SuggestOwners
+		if ownersAssign {
+			suggest = workflow.AssignOwners
+		}
+
+		reviewers, approvers, err := suggest(runtimeSettings)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("reviewers: %s\n", strings.Join(reviewers, ", "))
+		fmt.Printf("approvers: %s\n", strings.Join(approvers, ", "))
+
+		return nil
+	},
+}
+
+func init() {
+	ownersCmd.Flags().BoolVar(&ownersAssign, "assign", false, "add the suggested reviewers and approvers to the KEP")
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+var sigsList bool
+
+// sigsCmd represents the sigs command
+var sigsCmd = &cobra.Command{
+	Use:   "sigs",
+	Short: "show where SIG information is loaded from",
+	Long: `
+SIG information decides which directories KEPs may live in and who owns them.
+It is read from the sigs.yaml named by sigs_yaml_path in the user settings file
+or, when unset, a sigs.yaml at the root of the KEP content. When neither exists
+the SIG information compiled into the KEP tooling is used. Sigs prints which of
+these is in use and, with --list, each known SIG, working group, user group and
+committee along with its kind`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// SIG information is loaded before any command runs, see rootCmd
+		fmt.Printf("SIG information from: %s\n", sigs.Source())
+
+		if !sigsList {
+			fmt.Printf("%d SIGs, %d working groups, %d user groups and %d committees known\n",
+				len(sigs.GroupsOfKind(sigs.SIGKind)),
+				len(sigs.GroupsOfKind(sigs.WorkingGroupKind)),
+				len(sigs.GroupsOfKind(sigs.UserGroupKind)),
+				len(sigs.GroupsOfKind(sigs.CommitteeKind)),
+			)
+
+			return nil
+		}
+
+		for _, group := range sigs.Groups() {
+			fmt.Printf("%s\t%s\n", sigs.KindOf(group), group)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	sigsCmd.Flags().BoolVar(&sigsList, "list", false, "list each known group and its kind")
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var (
+	routingParticipatingSIGs []string
+	routingSubprojects       []string
+)
+
+// routingCmd represents the routing command
+var routingCmd = &cobra.Command{
+	Use:   "routing",
+	Short: "change the SIGs participating in a KEP and the subprojects it affects",
+	Long: `
+Routing replaces the SIGs participating in an existing KEP and the subprojects
+it affects. Only the lists given are replaced, pass an empty list (e.g.
+--participating-sigs "") to clear one. Participating SIGs may be SIGs, working
+groups, user groups or committees and each subproject must belong to the owning
+SIG or a participating SIG. The KEP checks are run again before any change is
+saved. The owning SIG and location of the KEP are not changed`,
+	Args: cobra.ExactArgs(1), // accept just one argument, location of KEP
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		principal, err := settings.FindPrincipal(userSettings)
+		if err != nil {
+			return err
+		}
+
+		// nil leaves the existing value alone
+		var participatingSIGs, subprojects []string
+		if cmd.Flags().Changed("participating-sigs") {
+			participatingSIGs = append([]string{}, routingParticipatingSIGs...)
+		}
+
+		if cmd.Flags().Changed("subprojects") {
+			subprojects = append([]string{}, routingSubprojects...)
+		}
+
+		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
+		err = workflow.UpdateRouting(runtimeSettings, participatingSIGs, subprojects)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("successfully updated KEP routing")
+		return printWarnings(runtimeSettings)
+	},
+}
+
+func init() {
+	routingCmd.Flags().StringSliceVar(&routingParticipatingSIGs, "participating-sigs", nil, "SIGs, working groups, user groups or committees collaborating on the KEP")
+	routingCmd.Flags().StringSliceVar(&routingSubprojects, "subprojects", nil, "subprojects affected by the KEP")
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+// moveCmd represents the move command
+var moveCmd = &cobra.Command{
+	Use:   "move <path-to-kep> <destination>",
+	Short: "move a KEP to another SIG or subproject",
+	Long: `
+Move relocates a KEP when its ownership changes. The destination is given in the
+same form as for init, for example:
+
+	kep move content/sig-node/kubelet/device-plugins sig-storage/kubernetes-csi/device-plugins
+
+will move the KEP to content/sig-storage/kubernetes-csi/device-plugins, making
+SIG Storage the owning SIG. The previous owning SIG becomes a participating SIG,
+the KEP index is updated and the former directory is left with a README pointing
+to the new location. A KEP may be moved back to a directory holding only such a
+README`,
+	Args: cobra.ExactArgs(2), // location of KEP and its destination
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetPath := args[0]
+		destination := args[1]
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		principal, err := settings.FindPrincipal(userSettings)
+		if err != nil {
+			return err
+		}
+
+		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
+		kepContentDir, err := workflow.Move(runtimeSettings, destination)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("moved KEP to: %s\n", kepContentDir)
+		return nil
+	},
+}
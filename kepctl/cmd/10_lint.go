@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/lint"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var (
+	lintFix      bool
+	lintFormat   string
+	lintSeverity string
+)
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint [path...]",
+	Short: "check KEPs for problems",
+	Long: `
+Lint runs every check against each KEP found under the given paths, or the whole
+KEP content when no paths are given, and reports each problem found with the
+file, rule and severity. Unlike other commands lint reads only KEP metadata so
+it reports on every KEP, including those which fail their checks. Checks which
+span KEPs, such as unique identifiers and agreement with the KEP index, are run
+across all KEPs found.
+
+Findings are written as text, json, github (GitHub Actions annotations) or
+sarif to stdout. Lint exits non-zero only when a finding is at least as severe
+as --severity (one of error, warning or info), saying so on stderr.
+
+With --fix routing info which does not match the location of a KEP is rewritten
+from the location, any previous owning SIG becomes a participating SIG`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := lint.ParseFormat(lintFormat)
+		if err != nil {
+			return err
+		}
+
+		threshold, err := check.ParseSeverity(lintSeverity)
+		if err != nil {
+			return err
+		}
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		// linting does not act on behalf of anyone so no principal is needed
+		runtimeSettings := settings.NewRuntime(contentRoot, "", "")
+		findings, err := workflow.Lint(runtimeSettings, args, lintFix)
+		if err != nil {
+			return err
+		}
+
+		for i := range findings {
+			findings[i].Path = workingDirRelative(findings[i].Path)
+		}
+
+		err = lint.Write(os.Stdout, format, findings)
+		if err != nil {
+			return err
+		}
+
+		if lint.AnyAtLeast(findings, threshold) {
+			// keep stdout to the findings alone, which may be read as json or sarif
+			cmd.SilenceUsage = true
+			fmt.Fprintf(os.Stderr, "found problems of at least %s severity\n", threshold)
+
+			return errReported
+		}
+
+		return nil
+	},
+}
+
+// workingDirRelative returns p relative to the working directory when p is
+// beneath it, which is what CI annotations expect
+func workingDirRelative(p string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return p
+	}
+
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return p
+	}
+
+	rel, err := filepath.Rel(wd, abs)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return p
+	}
+
+	return rel
+}
+
+func init() {
+	lintCmd.Flags().BoolVar(&lintFix, "fix", false, "rewrite KEP routing info to match the location of the KEP")
+	lintCmd.Flags().StringVar(&lintFormat, "format", string(lint.TextFormat), "output format: text, json, github or sarif")
+	lintCmd.Flags().StringVar(&lintSeverity, "severity", string(check.Error), "exit non-zero for findings of at least this severity: error, warning or info")
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/policy"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+// policyCmd represents the policy command
+var policyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "show the checks KEPs are held to",
+	Long: `
+Every KEP is held to a set of built in checks depending on its state. SIGs may
+require more through a ` + policy.Filename + ` at the root of the KEP content
+mapping SIGs and states to checks, for example:
+
+	rules:
+	- sigs: [sig-node]
+	  states: [implementable, implemented]
+	  checks: [stability-theme, non-author-approver]
+	  reason: SIG Node graduates features with a focus on stability
+
+Rules without sigs or states apply to every SIG or state, and a rule may set a
+severity of warning or info to advise rather than require. The policy is
+applied whenever a KEP is opened`,
+}
+
+// policyExplainCmd represents the policy explain command
+var policyExplainCmd = &cobra.Command{
+	Use:   "explain <path-to-kep>",
+	Short: "show which checks apply to a KEP and why",
+	Args:  cobra.ExactArgs(1), // accept just one argument, location of KEP
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetPath := args[0] // we have a validator ensuring we will have exactly one positional
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		// explaining does not act on behalf of anyone so no principal is needed
+		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, "")
+		explanations, err := workflow.ExplainPolicy(runtimeSettings)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "CHECK\tSEVERITY\tREASON")
+		for _, e := range explanations {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", e.Check, e.Severity, e.Reason)
+		}
+
+		return w.Flush()
+	},
+}
+
+// policyChecksCmd represents the policy checks command
+var policyChecksCmd = &cobra.Command{
+	Use:   "checks",
+	Short: "list the checks a policy may name",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, r := range check.Registry() {
+			fmt.Fprintf(w, "%s\t%s\n", r.Name, r.Description)
+		}
+
+		return w.Flush()
+	},
+}
+
+func init() {
+	policyCmd.AddCommand(policyExplainCmd)
+	policyCmd.AddCommand(policyChecksCmd)
+}
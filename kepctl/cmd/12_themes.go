@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/keps/themes"
+	"github.com/calebamiles/keps/pkg/settings"
+)
+
+var (
+	themeDescription string
+	themeOwners      []string
+)
+
+// themeCmd represents the theme command
+var themeCmd = &cobra.Command{
+	Use:   "theme",
+	Short: "manage the development themes KEPs can fall into",
+	Long: `
+Development themes group KEPs for planning and reporting on the roadmap in
+collaboration with SIG PM. Known themes are read from a ` + themes.Filename + ` at the root of
+the KEP content, alongside sigs.yaml, or, when there is none, from the themes
+compiled into the KEP tooling. KEPs listing an unknown theme are warned about`,
+}
+
+// themeListCmd represents the theme list command
+var themeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the known development themes",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// development themes are loaded before any command runs, see rootCmd
+		fmt.Printf("development themes from: %s\n", themes.Source())
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, t := range themes.All() {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, strings.Join(t.Owners, ", "), t.Description)
+		}
+
+		return w.Flush()
+	},
+}
+
+// themeAddCmd represents the theme add command
+var themeAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "add a development theme",
+	Long: `
+Add records a new development theme in the ` + themes.Filename + ` at the root of the KEP
+content, creating it from the themes currently known when needed`,
+	Args: cobra.ExactArgs(1), // accept just one argument, name of the theme
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0] // we have a validator ensuring we will have exactly one positional
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		t := themes.Theme{
+			Name:        name,
+			Description: themeDescription,
+			Owners:      themeOwners,
+		}
+
+		p := filepath.Join(contentRoot, themes.Filename)
+		err = themes.Add(p, t)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("added development theme: %s to: %s\n", name, p)
+		return nil
+	},
+}
+
+func init() {
+	themeAddCmd.Flags().StringVar(&themeDescription, "description", "", "what the theme covers")
+	themeAddCmd.Flags().StringSliceVar(&themeOwners, "owners", nil, "SIGs or people responsible for the theme")
+
+	themeCmd.AddCommand(themeListCmd)
+	themeCmd.AddCommand(themeAddCmd)
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var migrateWrite bool
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "upgrade KEP metadata to the current schema version",
+	Long: `
+Migrate finds every KEP whose metadata.yaml was written with an older schema
+version and lists the migrations which would upgrade it to the current schema
+version along with the lines of metadata.yaml they change. Nothing is changed
+on disk unless --write is given, so run migrate once to review the changes and
+again with --write to make them.
+
+KEP metadata is always upgraded in memory when read, so migrating is only
+needed to keep the KEP content on disk current`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		// migrating does not act on behalf of anyone so no principal is needed
+		runtimeSettings := settings.NewRuntime(contentRoot, "", "")
+		migrated, err := workflow.Migrate(runtimeSettings, migrateWrite)
+
+		for _, m := range migrated {
+			fmt.Println(workingDirRelative(m.ContentDir))
+			for _, applied := range m.Applied {
+				fmt.Printf("  %d -> %d: %s\n", applied.From, applied.From+1, applied.Description)
+			}
+
+			if !migrateWrite {
+				for _, line := range strings.SplitAfter(m.Diff, "\n") {
+					if line != "" {
+						fmt.Printf("    %s", line)
+					}
+				}
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case len(migrated) == 0:
+			fmt.Printf("all KEP metadata is at schema version: %d\n", metadata.SchemaVersion)
+		case migrateWrite:
+			fmt.Printf("migrated %d KEPs to schema version: %d\n", len(migrated), metadata.SchemaVersion)
+		default:
+			fmt.Printf("%d KEPs would be migrated to schema version: %d, run again with --write to migrate them\n", len(migrated), metadata.SchemaVersion)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	migrateCmd.Flags().BoolVar(&migrateWrite, "write", false, "write the migrated metadata to disk, by default changes are only listed")
+}
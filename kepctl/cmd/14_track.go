@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+// trackCmd represents the track command
+var trackCmd = &cobra.Command{
+	Use:   "track <kep> <pull request url>",
+	Short: "track a pull request implementing a KEP",
+	Long: `
+Track records a pull request implementing a KEP, such as one against
+kubernetes/kubernetes, in the KEP metadata. Once the pull request merges
+sync --update-state marks the KEP "implemented"`,
+	Args: cobra.ExactArgs(2), // location of KEP and the pull request URL
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targetPath := args[0]
+		prUrl := args[1]
+
+		contentRoot, err := settings.FindContentRoot(userSettings)
+		if err != nil {
+			return err
+		}
+
+		principal, err := settings.FindPrincipal(userSettings)
+		if err != nil {
+			return err
+		}
+
+		runtimeSettings := settings.NewRuntime(contentRoot, targetPath, principal)
+
+		err = workflow.Track(runtimeSettings, prUrl)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("tracking implementation pull request: %s\n", prUrl)
+		return nil
+	},
+}
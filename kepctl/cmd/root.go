@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/calebamiles/keps/pkg/settings"
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -22,12 +25,41 @@ CLI exercises. The happy path is as follows:
 2. [author] kep propose <path-to-created-kep>
 3. [SIG]    kep accept <path-to-created-kep>
 4. [author] kep plan <path-to-created-kep>
-5. [SIG]    kep approve <path-to-created-kep>`,
+5. [SIG]    kep approve <path-to-created-kep>
+
+Pull requests opened along the way are tracked in the KEP metadata, use
+kep sync <path-to-created-kep> to record their status. Pull requests
+implementing the KEP are tracked with kep track <path-to-created-kep> <url>. Reviewers and approvers
+are suggested from SIG OWNERS data by kep owners <path-to-created-kep>`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		userSettings, err = settings.ReadUser()
+		if err != nil {
+			return err
+		}
+
+		_, err = settings.LoadSIGs(userSettings)
+		if err != nil {
+			return err
+		}
+
+		_, err = settings.LoadThemes(userSettings)
+		return err
+	},
+	// Execute reports errors
+	SilenceErrors: true,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	//	Run: func(cmd *cobra.Command, args []string) { },
 }
 
+// userSettings are the user settings, read once before any command runs
+var userSettings *settings.User
+
+// errReported is returned by commands which have already reported why they
+// failed, Execute exits non-zero without printing anything more
+var errReported = errors.New("failure already reported")
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -37,9 +69,22 @@ func Execute() {
 	rootCmd.AddCommand(acceptCmd)
 	rootCmd.AddCommand(planCmd)
 	rootCmd.AddCommand(approveCmd)
+	rootCmd.AddCommand(syncCmd)
+	rootCmd.AddCommand(ownersCmd)
+	rootCmd.AddCommand(sigsCmd)
+	rootCmd.AddCommand(routingCmd)
+	rootCmd.AddCommand(moveCmd)
+	rootCmd.AddCommand(lintCmd)
+	rootCmd.AddCommand(policyCmd)
+	rootCmd.AddCommand(themeCmd)
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(trackCmd)
 
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		if err != errReported {
+			fmt.Fprintln(os.Stderr, err)
+		}
+
 		os.Exit(1)
 	}
 }
@@ -1,4 +1,4 @@
-package hermetic_test
+package auth_test
 
 import (
 	"testing"
@@ -7,7 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-func TestPorcelain(t *testing.T) {
+func TestAuth(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "Porcelain Suite")
+	RunSpecs(t, "Auth Suite")
 }
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// NewChainedTokenProvider returns a TokenProvider returning the first token
+// found by providers, in order. Once a provider has found a token only that
+// provider is asked for tokens
+func NewChainedTokenProvider(providers ...TokenProvider) TokenProvider {
+	var locker sync.Mutex
+	var found TokenProvider
+
+	var value = func() (string, error) {
+		locker.Lock()
+		defer locker.Unlock()
+
+		if found != nil {
+			return found.Value()
+		}
+
+		var errs *multierror.Error
+
+		for _, p := range providers {
+			token, err := p.Value()
+			if err == nil {
+				found = p
+				return token, nil
+			}
+
+			errs = multierror.Append(errs, err)
+		}
+
+		if errs == nil {
+			return "", ErrNoToken
+		}
+
+		return "", errs.ErrorOrNil()
+	}
+
+	return TokenProviderFunc(value)
+}
@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"os"
+)
+
+const (
+	// GitHubTokenEnv is the environment variable conventionally holding a GitHub token
+	GitHubTokenEnv = "GITHUB_TOKEN"
+)
+
+// NewProvideTokenFromEnv returns a TokenProvider reading the environment variable name
+func NewProvideTokenFromEnv(name string) TokenProvider {
+	var value = func() (string, error) {
+		return Validate(os.Getenv(name))
+	}
+
+	return TokenProviderFunc(value)
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultGitHubHost is the host of public GitHub
+	DefaultGitHubHost = "github.com"
+
+	ghConfigDirEnv   = "GH_CONFIG_DIR"
+	ghHostsFilename  = "hosts.yml"
+	xdgConfigHomeEnv = "XDG_CONFIG_HOME"
+)
+
+// GhConfigPath returns the location of the `gh` CLI hosts file
+func GhConfigPath() string {
+	if d := os.Getenv(ghConfigDirEnv); d != "" {
+		return filepath.Join(d, ghHostsFilename)
+	}
+
+	if d := os.Getenv(xdgConfigHomeEnv); d != "" {
+		return filepath.Join(d, "gh", ghHostsFilename)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".config", "gh", ghHostsFilename)
+}
+
+// NewProvideTokenFromGhConfig returns a TokenProvider reading the OAuth token
+// the `gh` CLI stored for host in the hosts file at p
+func NewProvideTokenFromGhConfig(p string, host string) TokenProvider {
+	var value = func() (string, error) {
+		hostsBytes, err := ioutil.ReadFile(p)
+		if err != nil {
+			return "", err
+		}
+
+		hosts := map[string]struct {
+			OAuthToken string `yaml:"oauth_token"`
+		}{}
+
+		err = yaml.Unmarshal(hostsBytes, &hosts)
+		if err != nil {
+			return "", err
+		}
+
+		return Validate(hosts[host].OAuthToken)
+	}
+
+	return TokenProviderFunc(value)
+}
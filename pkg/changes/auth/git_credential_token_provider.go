@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// NewProvideTokenFromGitCredentialHelper returns a TokenProvider asking the
+// configured git credential helper (`git credential fill`) for the password
+// stored for host
+func NewProvideTokenFromGitCredentialHelper(host string) TokenProvider {
+	var value = func() (string, error) {
+		cmd := exec.Command("git", "credential", "fill")
+		cmd.Stdin = strings.NewReader(fmt.Sprintf("protocol=https\nhost=%s\n\n", host))
+		cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0") // never prompt, just ask the helpers
+
+		var stdout bytes.Buffer
+		cmd.Stdout = &stdout
+
+		err := cmd.Run()
+		if err != nil {
+			return "", fmt.Errorf("running `git credential fill` for %s: %s", host, err)
+		}
+
+		scanner := bufio.NewScanner(&stdout)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "password=") {
+				return Validate(strings.TrimPrefix(line, "password="))
+			}
+		}
+
+		return "", ErrNoToken
+	}
+
+	return TokenProviderFunc(value)
+}
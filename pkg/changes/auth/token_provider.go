@@ -11,28 +11,19 @@ func NewProvideTokenFromPath(p string) (TokenProvider, error) {
 			return "", err
 		}
 
-		return string(tokenBytes), nil
+		return Validate(string(tokenBytes))
 	}
 
-	t := &tokenFromPath{
-		value: value,
-	}
-
-	return t, nil
+	return TokenProviderFunc(value), nil
 }
 
-
 type TokenProvider interface {
 	Value() (string, error)
 }
 
-type tokenValueFunc func() (string, error)
+// TokenProviderFunc adapts a function returning a token to a TokenProvider
+type TokenProviderFunc func() (string, error)
 
-type tokenFromPath struct {
-	value tokenValueFunc
+func (f TokenProviderFunc) Value() (string, error) {
+	return f()
 }
-
-func (t *tokenFromPath) Value() (string, error) {
-	return t.value()
-}
-
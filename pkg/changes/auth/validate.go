@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrNoToken is returned by a TokenProvider which found no token
+var ErrNoToken = errors.New("no GitHub token found")
+
+// githubTokenFormat matches personal access tokens (classic and fine grained),
+// OAuth, user to server, server to server (e.g. GitHub App installation) and
+// refresh tokens, as well as the older 40 character hex formats
+var githubTokenFormat = regexp.MustCompile(`^(gh[pousr]_[A-Za-z0-9]{20,255}|github_pat_[A-Za-z0-9_]{20,255}|(v1\.)?[0-9a-f]{40})$`)
+
+// Validate trims surrounding whitespace (e.g. the trailing newline of a token
+// file) from token and checks that what remains looks like a GitHub token
+func Validate(token string) (string, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", ErrNoToken
+	}
+
+	if !githubTokenFormat.MatchString(token) {
+		return "", fmt.Errorf("value of length %d does not look like a GitHub token", len(token))
+	}
+
+	return token, nil
+}
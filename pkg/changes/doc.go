@@ -0,0 +1,73 @@
+/*
+Package changes provides facilities for proposing changes to a Git repository
+hosted on GitHub. Changes are made in a local clone of the upstream
+repository, pushed to a branch of the principal's fork and delivered as a
+GitHub Pull Request against upstream.
+
+This package was designed to make it easy to connect the KEP process to the
+enhancements tracking process
+
+	userSettings, err := settings.ReadUser()
+	if err != nil {
+		// graceful handling
+	}
+
+	token, err := settings.FindToken(userSettings)
+	if err != nil {
+		// graceful handling
+	}
+
+	githubHandle, err := settings.FindPrincipal(userSettings)
+	if err != nil {
+		// graceful handling
+	}
+
+	client := github.NewClient(token, github.DefaultOptions())
+	author := changes.Author{Name: "Jane Doe", Email: "jane@example.com"}
+	upstream := changes.Upstream{Owner: "kubernetes", Repository: "enhancements-tracking"}
+
+	toLocation := filepath.Join(os.TempDir(), "an-unused-path")
+	withBranchName := "kep-title-targets-release-1.15"
+
+	proposal, err := changes.Fork(client, githubHandle, author, upstream, toLocation, withBranchName)
+	if err != nil {
+		// graceful handling
+	}
+
+	defer proposal.DeleteLocal()
+
+	err = proposal.Add("path-to-kep-summary.md", "release-1.15/proposed/kep-summary.md")
+	if err != nil {
+		// graceful handling
+	}
+
+	err = proposal.Commit("track enhancement for 1.15 release")
+	if err != nil {
+		// graceful handling
+	}
+
+	pr, err := proposal.OpenPR("Target delivery of enhancement KEP title", "")
+	if err != nil {
+		// graceful handling
+	}
+
+	fmt.Printf("proposed tracking KEP in PR: %s\n", pr.HtmlUrl)
+
+Proposals may also be made from an existing clone, such as the one containing
+the KEP content root, with Open, or from a clone of it with Copy which leaves
+the working tree of the existing clone alone.
+
+Where GitHub is not used, such as against a mirror on other Git hosting, Local
+commits changes to a branch of an existing clone. Local changes are submitted
+as a `git format-patch` style patch series, optionally pushing the branch to an
+arbitrary remote. Both are Reviewable, so callers may swap one review backend
+for another
+
+	var review changes.Reviewable = proposal
+	if useLocal {
+		review, err = changes.Local(author, repoRoot, withBranchName, changes.LocalOptions{PushTo: mirrorUrl})
+	}
+
+	location, err := review.Submit("Propose KEP: title", "description")
+*/
+package changes
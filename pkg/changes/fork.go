@@ -0,0 +1,138 @@
+package changes
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/calebamiles/keps/pkg/changes/git"
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+// Fork prepares a Proposal in a fresh clone of upstream at toLocation. Fork:
+//   - Issues a fork request to the GitHub API, waiting until the fork can be cloned
+//   - Clones the default branch from upstream
+//   - Sets the Git remote name "origin" to the principal's fork
+//   - Sets the Git remote name "upstream" to the upstream repository
+//   - Creates and checks out a new branch, from the upstream branch, to add changes
+func Fork(client *github.Client, principal string, author Author, upstream Upstream, toLocation string, withBranchName string) (Proposal, error) {
+	principal = strings.TrimPrefix(principal, "@")
+
+	_, err := client.Fork(context.Background(), upstream.Owner, upstream.Repository)
+	if err != nil {
+		log.Errorf("forking upstream %s/%s: %s", upstream.Owner, upstream.Repository, err)
+		return nil, err
+	}
+
+	upstreamLocation := client.Options.RepoGitUrl(upstream.Owner, upstream.Repository)
+
+	repo, err := git.Clone(client.Token, upstreamLocation, toLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.SetRemote(git.UpstreamRemoteName, upstreamLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.SetOrigin(client.Options.RepoGitUrl(principal, upstream.Repository))
+	if err != nil {
+		return nil, err
+	}
+
+	return newProposal(repo, client, principal, author, upstream, toLocation, withBranchName)
+}
+
+// Open prepares a Proposal in an existing clone of upstream at localPath whose
+// "origin" remote is the principal's fork. The Git remote name "upstream" is set
+// to the upstream repository and new files which have not been committed are
+// carried over to the new branch
+func Open(client *github.Client, principal string, author Author, upstream Upstream, localPath string, withBranchName string) (Proposal, error) {
+	repo, err := git.Open(localPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return openProposal(repo, client, principal, author, upstream, localPath, withBranchName)
+}
+
+// Copy prepares a Proposal, like Open, in a clone at toLocation of the existing
+// clone of upstream at localPath. The clone has the remotes of localPath, whose
+// "origin" is the principal's fork, and the working tree at localPath is left
+// alone. Only committed files are carried over to the new branch
+func Copy(client *github.Client, principal string, author Author, upstream Upstream, localPath string, toLocation string, withBranchName string) (Proposal, error) {
+	repo, err := git.CloneLocal(localPath, toLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return openProposal(repo, client, principal, author, upstream, toLocation, withBranchName)
+}
+
+// openProposal sets the Git remote name "upstream" to the upstream repository
+// of an existing clone and starts withBranchName from it
+func openProposal(repo git.Repo, client *github.Client, principal string, author Author, upstream Upstream, localPath string, withBranchName string) (Proposal, error) {
+	err := repo.SetRemote(git.UpstreamRemoteName, client.Options.RepoGitUrl(upstream.Owner, upstream.Repository))
+	if err != nil {
+		return nil, err
+	}
+
+	return newProposal(repo, client, strings.TrimPrefix(principal, "@"), author, upstream, localPath, withBranchName)
+}
+
+// newProposal starts withBranchName from the latest upstream branch. An existing
+// branch is checked out and fast-forwarded when it has not diverged from upstream
+func newProposal(repo git.Repo, client *github.Client, principal string, author Author, upstream Upstream, localPath string, withBranchName string) (Proposal, error) {
+	err := repo.Fetch(client.Token, git.UpstreamRemoteName)
+	if err != nil {
+		return nil, err
+	}
+
+	if upstream.Branch == "" {
+		upstream.Branch, err = repo.DefaultBranch(client.Token, git.UpstreamRemoteName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = repo.CreateBranchFrom(git.UpstreamRemoteName, upstream.Branch, withBranchName)
+	switch err {
+	case nil:
+		// ready for changes
+	case git.ErrBranchExists:
+		err = repo.Checkout(withBranchName)
+		if err != nil {
+			log.Errorf("checking out branch %s: %s", withBranchName, err)
+			return nil, err
+		}
+
+		err = repo.FastForward(git.UpstreamRemoteName, upstream.Branch)
+		if err == git.ErrNotFastForward {
+			log.Warnf("branch %s has diverged from %s/%s, leaving it as is", withBranchName, git.UpstreamRemoteName, upstream.Branch)
+			err = nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	default:
+		log.Errorf("creating branch %s from %s/%s: %s", withBranchName, git.UpstreamRemoteName, upstream.Branch, err)
+		return nil, err
+	}
+
+	p := &proposal{
+		repo:       repo,
+		client:     client,
+		principal:  principal,
+		author:     author,
+		upstream:   upstream,
+		localPath:  localPath,
+		branchName: withBranchName,
+		locker:     &sync.Mutex{},
+	}
+
+	return p, nil
+}
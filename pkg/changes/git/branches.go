@@ -1,5 +1,217 @@
 package git
 
-const (
-	DefaultBranchName = "master"
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	libgit "gopkg.in/src-d/go-git.v4"
+	libgitconfig "gopkg.in/src-d/go-git.v4/config"
+	libgitplumbing "gopkg.in/src-d/go-git.v4/plumbing"
+	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/storer"
+)
+
+var (
+	// ErrBranchExists is returned when creating a branch which already exists
+	ErrBranchExists = errors.New("branch already exists")
+
+	// ErrNotFastForward is returned when the checked out branch has diverged from the branch it is being updated to
+	ErrNotFastForward = errors.New("branch has diverged and cannot be fast-forwarded")
 )
+
+// CreateBranchFrom creates branchName at the remote tracking branch remoteName/remoteBranch,
+// which should be fetched first, and checks it out. Like `git checkout -b`, new files
+// which have not been committed are carried over to the new branch
+func (r *repository) CreateBranchFrom(remoteName string, remoteBranch string, branchName string) error {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	fullBranchName := libgitplumbing.NewBranchReferenceName(branchName)
+
+	_, err := r.underlying.Reference(fullBranchName, false)
+	switch err {
+	case nil:
+		return ErrBranchExists
+	case libgitplumbing.ErrReferenceNotFound:
+		// continue
+	default:
+		return err
+	}
+
+	base, err := r.underlying.Reference(libgitplumbing.NewRemoteReferenceName(remoteName, remoteBranch), true)
+	if err != nil {
+		log.Errorf("resolving remote branch %s/%s: %s", remoteName, remoteBranch, err)
+		return err
+	}
+
+	err = r.moveBranch(fullBranchName, base.Hash())
+	if err != nil {
+		log.Errorf("checking out new branch: %s with error: %s", branchName, err)
+		return err
+	}
+
+	err = r.underlying.CreateBranch(&libgitconfig.Branch{Name: branchName, Remote: remoteName, Merge: libgitplumbing.NewBranchReferenceName(remoteBranch)})
+	if err != nil && err != libgit.ErrBranchExists {
+		log.Errorf("configuring branch: %s with error: %s", branchName, err)
+		return err
+	}
+
+	return nil
+}
+
+// FastForward moves the checked out branch forward to the remote tracking branch
+// remoteName/remoteBranch. Nothing is done when the checked out branch already
+// contains the remote branch, ErrNotFastForward is returned when the two have diverged
+func (r *repository) FastForward(remoteName string, remoteBranch string) error {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	head, err := r.underlying.Head()
+	if err != nil {
+		return err
+	}
+
+	if !head.Name().IsBranch() {
+		return fmt.Errorf("cannot fast-forward, no branch is checked out")
+	}
+
+	target, err := r.underlying.Reference(libgitplumbing.NewRemoteReferenceName(remoteName, remoteBranch), true)
+	if err != nil {
+		log.Errorf("resolving remote branch %s/%s: %s", remoteName, remoteBranch, err)
+		return err
+	}
+
+	behind, err := r.isAncestor(head.Hash(), target.Hash())
+	if err != nil {
+		return err
+	}
+
+	if behind {
+		return r.moveBranch(head.Name(), target.Hash())
+	}
+
+	ahead, err := r.isAncestor(target.Hash(), head.Hash())
+	if err != nil {
+		return err
+	}
+
+	if ahead {
+		return nil
+	}
+
+	return ErrNotFastForward
+}
+
+// moveBranch points branch at hash and checks it out. New files which have not been
+// committed are preserved, uncommitted changes to tracked files are refused rather than lost
+func (r *repository) moveBranch(branch libgitplumbing.ReferenceName, hash libgitplumbing.Hash) error {
+	head, err := r.underlying.Head()
+	if err == nil && head.Hash() == hash {
+		// the work tree already matches, only the branch and HEAD need to change
+		err = r.underlying.Storer.SetReference(libgitplumbing.NewHashReference(branch, hash))
+		if err != nil {
+			return err
+		}
+
+		return r.underlying.Storer.SetReference(libgitplumbing.NewSymbolicReference(libgitplumbing.HEAD, branch))
+	}
+
+	worktree, err := r.underlying.Worktree()
+	if err != nil {
+		return err
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return err
+	}
+
+	type keptFile struct {
+		content []byte
+		mode    os.FileMode
+		staged  bool
+	}
+
+	kept := map[string]keptFile{}
+	for name, fileStatus := range status {
+		switch {
+		case fileStatus.Worktree == libgit.Untracked, fileStatus.Staging == libgit.Added && fileStatus.Worktree == libgit.Unmodified:
+			p := filepath.Join(r.localPath, filepath.FromSlash(name))
+
+			info, err := os.Stat(p)
+			if err != nil {
+				return err
+			}
+
+			content, err := ioutil.ReadFile(p)
+			if err != nil {
+				return err
+			}
+
+			kept[name] = keptFile{content: content, mode: info.Mode(), staged: fileStatus.Staging == libgit.Added}
+
+		case fileStatus.Worktree == libgit.Unmodified && fileStatus.Staging == libgit.Unmodified:
+			// nothing to preserve
+
+		default:
+			return fmt.Errorf("uncommitted changes to %s would be lost by switching to %s. Please commit or remove them", name, branch.Short())
+		}
+	}
+
+	err = r.underlying.Storer.SetReference(libgitplumbing.NewHashReference(branch, hash))
+	if err != nil {
+		return err
+	}
+
+	err = worktree.Checkout(&libgit.CheckoutOptions{Branch: branch, Force: true})
+	if err != nil {
+		return err
+	}
+
+	for name, file := range kept {
+		p := filepath.Join(r.localPath, filepath.FromSlash(name))
+
+		err = os.MkdirAll(filepath.Dir(p), os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(p, file.content, file.mode)
+		if err != nil {
+			return err
+		}
+
+		if file.staged {
+			_, err = worktree.Add(name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isAncestor returns whether ancestor is reachable from the commit identified by of
+func (r *repository) isAncestor(ancestor libgitplumbing.Hash, of libgitplumbing.Hash) (bool, error) {
+	commit, err := r.underlying.CommitObject(of)
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	err = libgitobject.NewCommitPreorderIter(commit, nil, nil).ForEach(func(c *libgitobject.Commit) error {
+		if c.Hash != ancestor {
+			return nil
+		}
+
+		found = true
+		return storer.ErrStop
+	})
+
+	return found, err
+}
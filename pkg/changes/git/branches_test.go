@@ -0,0 +1,188 @@
+package git_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	libgit "gopkg.in/src-d/go-git.v4"
+	libgitconfig "gopkg.in/src-d/go-git.v4/config"
+	libgitplumbing "gopkg.in/src-d/go-git.v4/plumbing"
+	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes/git"
+)
+
+var _ = Describe("keeping up with upstream", func() {
+	const defaultBranch = "main"
+
+	var (
+		tmpDir      string
+		upstreamDir string
+		seedDir     string
+		localDir    string
+		seed        *libgit.Repository
+		repo        git.Repo
+	)
+
+	// commitToUpstream commits content to README.md in the seed repository and pushes it upstream
+	commitToUpstream := func(content string) libgitplumbing.Hash {
+		Expect(ioutil.WriteFile(filepath.Join(seedDir, "README.md"), []byte(content), os.ModePerm)).To(Succeed())
+
+		worktree, err := seed.Worktree()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = worktree.Add("README.md")
+		Expect(err).ToNot(HaveOccurred())
+
+		hash, err := worktree.Commit(content, &libgit.CommitOptions{
+			Author: &libgitobject.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = seed.Push(&libgit.PushOptions{
+			RemoteName: "origin",
+			RefSpecs:   []libgitconfig.RefSpec{libgitconfig.RefSpec("refs/heads/master:refs/heads/" + defaultBranch)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		return hash
+	}
+
+	headOf := func(p string) *libgitplumbing.Reference {
+		underlying, err := libgit.PlainOpen(p)
+		Expect(err).ToNot(HaveOccurred())
+
+		head, err := underlying.Head()
+		Expect(err).ToNot(HaveOccurred())
+
+		return head
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "kep-git-upstream")
+		Expect(err).ToNot(HaveOccurred())
+
+		upstreamDir = filepath.Join(tmpDir, "upstream.git")
+		seedDir = filepath.Join(tmpDir, "seed")
+		localDir = filepath.Join(tmpDir, "local")
+
+		upstream, err := libgit.PlainInit(upstreamDir, true)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = upstream.Storer.SetReference(libgitplumbing.NewSymbolicReference(libgitplumbing.HEAD, libgitplumbing.NewBranchReferenceName(defaultBranch)))
+		Expect(err).ToNot(HaveOccurred())
+
+		seed, err = libgit.PlainInit(seedDir, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = seed.CreateRemote(&libgitconfig.RemoteConfig{Name: "origin", URLs: []string{upstreamDir}})
+		Expect(err).ToNot(HaveOccurred())
+
+		commitToUpstream("# Enhancements\n")
+
+		_, err = libgit.PlainClone(localDir, false, &libgit.CloneOptions{URL: upstreamDir})
+		Expect(err).ToNot(HaveOccurred())
+
+		repo, err = git.Open(localDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(repo.SetRemote(git.UpstreamRemoteName, upstreamDir)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	Describe("#DefaultBranch()", func() {
+		It("returns the branch HEAD refers to upstream", func() {
+			branch, err := repo.DefaultBranch(nil, git.UpstreamRemoteName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(branch).To(Equal(defaultBranch))
+		})
+	})
+
+	Describe("#CreateBranchFrom()", func() {
+		It("starts the branch from the latest upstream commit, keeping new files", func() {
+			latest := commitToUpstream("# Enhancements, updated\n")
+
+			newKEP := filepath.Join(localDir, "keps", "a-great-kep", "metadata.yaml")
+			Expect(os.MkdirAll(filepath.Dir(newKEP), os.ModePerm)).To(Succeed())
+			Expect(ioutil.WriteFile(newKEP, []byte("title: A Great KEP\n"), os.ModePerm)).To(Succeed())
+
+			Expect(repo.Fetch(nil, git.UpstreamRemoteName)).To(Succeed())
+			Expect(repo.CreateBranchFrom(git.UpstreamRemoteName, defaultBranch, "a-great-kep")).To(Succeed())
+
+			head := headOf(localDir)
+			Expect(head.Name().Short()).To(Equal("a-great-kep"))
+			Expect(head.Hash()).To(Equal(latest))
+
+			readme, err := ioutil.ReadFile(filepath.Join(localDir, "README.md"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(readme)).To(Equal("# Enhancements, updated\n"))
+
+			content, err := ioutil.ReadFile(newKEP)
+			Expect(err).ToNot(HaveOccurred(), "expected new files to be carried over to the branch")
+			Expect(string(content)).To(Equal("title: A Great KEP\n"))
+		})
+
+		It("refuses to discard changes to tracked files", func() {
+			commitToUpstream("# Enhancements, updated\n")
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "README.md"), []byte("local edit\n"), os.ModePerm)).To(Succeed())
+
+			Expect(repo.Fetch(nil, git.UpstreamRemoteName)).To(Succeed())
+			Expect(repo.CreateBranchFrom(git.UpstreamRemoteName, defaultBranch, "a-great-kep")).ToNot(Succeed())
+
+			readme, err := ioutil.ReadFile(filepath.Join(localDir, "README.md"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(readme)).To(Equal("local edit\n"))
+		})
+
+		It("returns ErrBranchExists when the branch exists", func() {
+			Expect(repo.Fetch(nil, git.UpstreamRemoteName)).To(Succeed())
+			Expect(repo.CreateBranchFrom(git.UpstreamRemoteName, defaultBranch, "a-great-kep")).To(Succeed())
+			Expect(repo.CreateBranchFrom(git.UpstreamRemoteName, defaultBranch, "a-great-kep")).To(Equal(git.ErrBranchExists))
+		})
+	})
+
+	Describe("#FastForward()", func() {
+		It("moves the checked out branch to the latest upstream commit", func() {
+			latest := commitToUpstream("# Enhancements, updated\n")
+
+			Expect(repo.Fetch(nil, git.UpstreamRemoteName)).To(Succeed())
+			Expect(repo.FastForward(git.UpstreamRemoteName, defaultBranch)).To(Succeed())
+
+			head := headOf(localDir)
+			Expect(head.Name().Short()).To(Equal(defaultBranch))
+			Expect(head.Hash()).To(Equal(latest))
+		})
+
+		It("leaves branches which are ahead of upstream alone", func() {
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "local.md"), []byte("local\n"), os.ModePerm)).To(Succeed())
+			Expect(repo.Add("local.md")).To(Succeed())
+			Expect(repo.Commit("Jane Doe", "jane@example.com", "add a local change")).To(Succeed())
+
+			ahead := headOf(localDir).Hash()
+
+			Expect(repo.Fetch(nil, git.UpstreamRemoteName)).To(Succeed())
+			Expect(repo.FastForward(git.UpstreamRemoteName, defaultBranch)).To(Succeed())
+			Expect(headOf(localDir).Hash()).To(Equal(ahead))
+		})
+
+		It("returns ErrNotFastForward when the branch has diverged", func() {
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "local.md"), []byte("local\n"), os.ModePerm)).To(Succeed())
+			Expect(repo.Add("local.md")).To(Succeed())
+			Expect(repo.Commit("Jane Doe", "jane@example.com", "add a local change")).To(Succeed())
+
+			commitToUpstream("# Enhancements, updated\n")
+
+			Expect(repo.Fetch(nil, git.UpstreamRemoteName)).To(Succeed())
+			Expect(repo.FastForward(git.UpstreamRemoteName, defaultBranch)).To(Equal(git.ErrNotFastForward))
+		})
+	})
+})
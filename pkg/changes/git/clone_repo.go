@@ -70,3 +70,45 @@ func Clone(token auth.TokenProvider, repoUrl string, toLocation string) (Repo, e
 		return nil, fmt.Errorf("could not obtain exlusive file lock when cloning repository")
 	}
 }
+
+// CloneLocal clones the repository at fromLocation, such as the principal's own
+// clone, to toLocation so that changes can be made without touching the working
+// tree at fromLocation. The clone has the remotes of the repository at
+// fromLocation rather than an `origin` referring to fromLocation
+func CloneLocal(fromLocation string, toLocation string) (Repo, error) {
+	if _, err := os.Stat(toLocation); !os.IsNotExist(err) {
+		log.Errorf("location: %s may exist already, refusing to overwrite", toLocation)
+		return nil, fmt.Errorf("location: %s may exist already, refusing to overwrite", toLocation)
+	}
+
+	from, err := libgit.PlainOpen(fromLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	remotes, err := from.Remotes()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = libgit.PlainClone(toLocation, false, &libgit.CloneOptions{URL: fromLocation})
+	if err != nil {
+		log.Errorf("cloning %s: %s", fromLocation, err)
+		return nil, err
+	}
+
+	r, err := Open(toLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, remote := range remotes {
+		cfg := remote.Config()
+		err = r.SetRemote(cfg.Name, cfg.URLs[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
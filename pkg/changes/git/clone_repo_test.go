@@ -4,8 +4,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	libgit "gopkg.in/src-d/go-git.v4"
+	libgitconfig "gopkg.in/src-d/go-git.v4/config"
+	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
 
 	"github.com/calebamiles/keps/pkg/changes/auth/authfakes"
 
@@ -85,4 +88,55 @@ var _ = Describe("cloning a Git repo to disk", func() {
 			})
 		})
 	})
+
+	Describe("CloneLocal()", func() {
+		It("clones a local repository keeping its remotes", func() {
+			tmpDir, err := ioutil.TempDir("", "keps-clone-local-test")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpDir)
+
+			fromDir := filepath.Join(tmpDir, "from")
+			from, err := libgit.PlainInit(fromDir, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(ioutil.WriteFile(filepath.Join(fromDir, "README.md"), []byte("# Hello\n"), 0644)).To(Succeed())
+
+			worktree, err := from.Worktree()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = worktree.Add("README.md")
+			Expect(err).ToNot(HaveOccurred())
+
+			hash, err := worktree.Commit("initial commit", &libgit.CommitOptions{
+				Author: &libgitobject.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = from.CreateRemote(&libgitconfig.RemoteConfig{Name: git.OriginRemoteName, URLs: []string{exampleRepoUrl}})
+			Expect(err).ToNot(HaveOccurred())
+
+			By("leaving the working tree of the original repository alone")
+			Expect(ioutil.WriteFile(filepath.Join(fromDir, "README.md"), []byte("# Uncommitted\n"), 0644)).To(Succeed())
+
+			toDir := filepath.Join(tmpDir, "to")
+			repo, err := git.CloneLocal(fromDir, toDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			head, err := repo.Head()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(head).To(Equal(hash.String()))
+
+			content, err := ioutil.ReadFile(filepath.Join(toDir, "README.md"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("# Hello\n"))
+
+			By("keeping the remotes of the original repository")
+			cloned, err := libgit.PlainOpen(toDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			origin, err := cloned.Remote(git.OriginRemoteName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(origin.Config().URLs).To(Equal([]string{exampleRepoUrl}))
+		})
+	})
 })
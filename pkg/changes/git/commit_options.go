@@ -0,0 +1,47 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CommitOptions describe the author of a commit and how the commit should be attributed
+type CommitOptions struct {
+	Name  string
+	Email string
+
+	// SignOff adds a `Signed-off-by` trailer for the author, certifying the
+	// Developer Certificate of Origin
+	SignOff bool
+
+	// CoAuthors are added as `Co-authored-by` trailers, each should be of the form `Name <email>`
+	CoAuthors []string
+
+	// Signer, when set, signs the commit
+	Signer Signer
+}
+
+// message returns message followed by any requested trailers
+func (o CommitOptions) message(message string) string {
+	trailers := []string{}
+
+	for _, coAuthor := range o.CoAuthors {
+		trailer := fmt.Sprintf("Co-authored-by: %s", coAuthor)
+		if !strings.Contains(message, trailer) {
+			trailers = append(trailers, trailer)
+		}
+	}
+
+	if o.SignOff {
+		trailer := fmt.Sprintf("Signed-off-by: %s <%s>", o.Name, o.Email)
+		if !strings.Contains(message, trailer) {
+			trailers = append(trailers, trailer)
+		}
+	}
+
+	if len(trailers) == 0 {
+		return message
+	}
+
+	return fmt.Sprintf("%s\n\n%s\n", strings.TrimRight(message, "\n"), strings.Join(trailers, "\n"))
+}
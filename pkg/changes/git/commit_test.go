@@ -0,0 +1,168 @@
+package git_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	libgit "gopkg.in/src-d/go-git.v4"
+	libgitplumbing "gopkg.in/src-d/go-git.v4/plumbing"
+	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes/git"
+)
+
+var _ = Describe("committing changes", func() {
+	var (
+		tmpDir   string
+		repoPath string
+		repo     git.Repo
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "kep-git-commit")
+		Expect(err).ToNot(HaveOccurred())
+
+		repoPath = filepath.Join(tmpDir, "repo")
+		_, err = libgit.PlainInit(repoPath, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		repo, err = git.Open(repoPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ioutil.WriteFile(filepath.Join(repoPath, "example.md"), []byte("example content"), os.ModePerm)).To(Succeed())
+		Expect(repo.Add("example.md")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	headCommit := func() *libgitobject.Commit {
+		underlying, err := libgit.PlainOpen(repoPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		head, err := underlying.Head()
+		Expect(err).ToNot(HaveOccurred())
+
+		commit, err := underlying.CommitObject(head.Hash())
+		Expect(err).ToNot(HaveOccurred())
+
+		return commit
+	}
+
+	It("commits as the author with the KEP tool as committer", func() {
+		Expect(repo.Commit("Jane Doe", "jane@example.com", "add an example")).To(Succeed())
+
+		commit := headCommit()
+		Expect(commit.Author.Name).To(Equal("Jane Doe"))
+		Expect(commit.Author.Email).To(Equal("jane@example.com"))
+		Expect(commit.Committer.Name).To(Equal("OSS KEP Tool"))
+		Expect(commit.Message).To(Equal("add an example"))
+		Expect(commit.PGPSignature).To(BeEmpty())
+	})
+
+	It("adds co-author and sign-off trailers", func() {
+		opts := git.CommitOptions{
+			Name:      "Jane Doe",
+			Email:     "jane@example.com",
+			SignOff:   true,
+			CoAuthors: []string{"John Doe <john@example.com>"},
+		}
+
+		Expect(repo.CommitWithOptions("add an example\n", opts)).To(Succeed())
+
+		Expect(headCommit().Message).To(Equal("add an example\n\nCo-authored-by: John Doe <john@example.com>\nSigned-off-by: Jane Doe <jane@example.com>\n"))
+	})
+
+	It("does not repeat a sign-off already present in the message", func() {
+		opts := git.CommitOptions{Name: "Jane Doe", Email: "jane@example.com", SignOff: true}
+
+		Expect(repo.CommitWithOptions("add an example\n\nSigned-off-by: Jane Doe <jane@example.com>\n", opts)).To(Succeed())
+
+		Expect(strings.Count(headCommit().Message, "Signed-off-by")).To(Equal(1))
+	})
+
+	It("signs commits with an OpenPGP key", func() {
+		entity, err := openpgp.NewEntity("Jane Doe", "", "jane@example.com", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		var privateKey bytes.Buffer
+		w, err := armor.Encode(&privateKey, openpgp.PrivateKeyType, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entity.SerializePrivate(w, nil)).To(Succeed())
+		Expect(w.Close()).To(Succeed())
+
+		var publicKey bytes.Buffer
+		w, err = armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entity.Serialize(w)).To(Succeed())
+		Expect(w.Close()).To(Succeed())
+
+		keyPath := filepath.Join(tmpDir, "signing.asc")
+		Expect(ioutil.WriteFile(keyPath, privateKey.Bytes(), 0600)).To(Succeed())
+
+		signer, err := git.NewGPGSigner(keyPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := git.CommitOptions{Name: "Jane Doe", Email: "jane@example.com", Signer: signer}
+		Expect(repo.CommitWithOptions("add an example", opts)).To(Succeed())
+
+		commit := headCommit()
+		Expect(commit.Committer.Name).To(Equal("Jane Doe"), "expected signed commits to be committed by the author")
+
+		_, err = commit.Verify(publicKey.String())
+		Expect(err).ToNot(HaveOccurred(), "expected the commit signature to verify")
+
+		By("updating the branch to point at the signed commit")
+		underlying, err := libgit.PlainOpen(repoPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		master, err := underlying.Reference(libgitplumbing.Master, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(master.Hash()).To(Equal(commit.Hash))
+	})
+
+	It("signs commits with an SSH key", func() {
+		if _, err := exec.LookPath("ssh-keygen"); err != nil {
+			Skip("ssh-keygen unavailable and required for test")
+		}
+
+		keyPath := filepath.Join(tmpDir, "id_ed25519")
+		Expect(exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath).Run()).To(Succeed())
+
+		opts := git.CommitOptions{Name: "Jane Doe", Email: "jane@example.com", Signer: git.NewSSHSigner(keyPath)}
+		Expect(repo.CommitWithOptions("add an example", opts)).To(Succeed())
+
+		commit := headCommit()
+		Expect(commit.PGPSignature).To(HavePrefix("-----BEGIN SSH SIGNATURE-----"))
+
+		By("verifying the signature covers the unsigned commit")
+		signaturePath := filepath.Join(tmpDir, "commit.sig")
+		Expect(ioutil.WriteFile(signaturePath, []byte(commit.PGPSignature), 0600)).To(Succeed())
+
+		unsigned := *commit
+		unsigned.PGPSignature = ""
+
+		encoded := &libgitplumbing.MemoryObject{}
+		Expect(unsigned.Encode(encoded)).To(Succeed())
+
+		content, err := encoded.Reader()
+		Expect(err).ToNot(HaveOccurred())
+		defer content.Close()
+
+		check := exec.Command("ssh-keygen", "-Y", "check-novalidate", "-n", "git", "-s", signaturePath)
+		check.Stdin = content
+		out, err := check.CombinedOutput()
+		Expect(err).ToNot(HaveOccurred(), string(out))
+	})
+})
@@ -0,0 +1,264 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	log "github.com/sirupsen/logrus"
+	libgitplumbing "gopkg.in/src-d/go-git.v4/plumbing"
+	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+const (
+	// CoverLetterFilename is the name of the patch introducing a series
+	CoverLetterFilename = "0000-cover-letter.patch"
+
+	// patchTimestamp is the fixed date `git format-patch` uses to mark the start of each patch
+	patchTimestamp = "Mon Sep 17 00:00:00 2001"
+
+	// maxPatchNameLength matches the length `git format-patch` truncates file names to
+	maxPatchNameLength = 52
+)
+
+// PatchOptions describe a patch series. When CoverSubject is set a cover letter
+// introducing the series, from Name and Email, is written first
+type PatchOptions struct {
+	Since string // revision after which the series starts
+	ToDir string
+
+	CoverSubject string
+	CoverBody    string
+	Name         string
+	Email        string
+}
+
+// FormatPatch writes each non-merge commit reachable from HEAD but not from opts.Since,
+// oldest first, to opts.ToDir as a patch in the mbox format produced by `git format-patch`
+// returning the locations of the patches written
+func (r *repository) FormatPatch(opts PatchOptions) ([]string, error) {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	commits, err := r.commitsSince(opts.Since)
+	if err != nil {
+		log.Errorf("finding commits since %s: %s", opts.Since, err)
+		return nil, err
+	}
+
+	err = os.MkdirAll(opts.ToDir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	written := []string{}
+
+	if opts.CoverSubject != "" {
+		p := filepath.Join(opts.ToDir, CoverLetterFilename)
+
+		err = ioutil.WriteFile(p, coverLetter(opts, commits), 0644)
+		if err != nil {
+			return nil, err
+		}
+
+		written = append(written, p)
+	}
+
+	for i, commit := range commits {
+		content, err := formatPatch(commit, i+1, len(commits))
+		if err != nil {
+			log.Errorf("formatting patch for commit %s: %s", commit.Hash, err)
+			return nil, err
+		}
+
+		p := filepath.Join(opts.ToDir, patchName(i+1, commit.Message))
+
+		err = ioutil.WriteFile(p, content, 0644)
+		if err != nil {
+			return nil, err
+		}
+
+		written = append(written, p)
+	}
+
+	return written, nil
+}
+
+// Head returns the hash of the commit at HEAD
+func (r *repository) Head() (string, error) {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	head, err := r.underlying.Head()
+	if err != nil {
+		return "", err
+	}
+
+	return head.Hash().String(), nil
+}
+
+// commitsSince returns the non-merge commits on the first parent history of HEAD
+// which are not reachable from since, oldest first
+func (r *repository) commitsSince(since string) ([]*libgitobject.Commit, error) {
+	sinceHash, err := r.underlying.ResolveRevision(libgitplumbing.Revision(since))
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := r.underlying.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := r.underlying.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	commits := []*libgitobject.Commit{}
+	for {
+		reachable, err := r.isAncestor(commit.Hash, *sinceHash)
+		if err != nil {
+			return nil, err
+		}
+
+		if reachable {
+			break
+		}
+
+		if commit.NumParents() < 2 {
+			commits = append([]*libgitobject.Commit{commit}, commits...)
+		}
+
+		if commit.NumParents() == 0 {
+			break
+		}
+
+		commit, err = commit.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return commits, nil
+}
+
+func formatPatch(commit *libgitobject.Commit, n int, total int) ([]byte, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	var parentTree *libgitobject.Tree
+	if commit.NumParents() > 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := libgitobject.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := changes.Patch()
+	if err != nil {
+		return nil, err
+	}
+
+	subject, body := splitMessage(commit.Message)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From %s %s\n", commit.Hash, patchTimestamp)
+	fmt.Fprintf(&b, "From: %s <%s>\n", commit.Author.Name, commit.Author.Email)
+	fmt.Fprintf(&b, "Date: %s\n", commit.Author.When.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Subject: %s %s\n\n", patchPrefix(n, total), subject)
+
+	if body != "" {
+		fmt.Fprintf(&b, "%s\n", body)
+	}
+
+	fmt.Fprintf(&b, "---\n%s\n", patch.Stats())
+
+	err = patch.Encode(&b)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(&b, "-- \nkep-tool\n\n")
+
+	return b.Bytes(), nil
+}
+
+func coverLetter(opts PatchOptions, commits []*libgitobject.Commit) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From %s %s\n", libgitplumbing.ZeroHash, patchTimestamp)
+	fmt.Fprintf(&b, "From: %s <%s>\n", opts.Name, opts.Email)
+	fmt.Fprintf(&b, "Date: %s\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Subject: [PATCH 0/%d] %s\n\n", len(commits), opts.CoverSubject)
+
+	if opts.CoverBody != "" {
+		fmt.Fprintf(&b, "%s\n\n", strings.TrimRight(opts.CoverBody, "\n"))
+	}
+
+	for _, commit := range commits {
+		subject, _ := splitMessage(commit.Message)
+		fmt.Fprintf(&b, "  %s\n", subject)
+	}
+
+	fmt.Fprintf(&b, "\n-- \nkep-tool\n\n")
+
+	return b.Bytes()
+}
+
+func patchPrefix(n int, total int) string {
+	if total == 1 {
+		return "[PATCH]"
+	}
+
+	return fmt.Sprintf("[PATCH %d/%d]", n, total)
+}
+
+// splitMessage returns the subject and body of a commit message
+func splitMessage(message string) (string, string) {
+	parts := strings.SplitN(strings.TrimSpace(message), "\n", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// patchName returns a file name like `git format-patch` does, e.g. 0001-add-a-great-kep.patch
+func patchName(n int, message string) string {
+	subject, _ := splitMessage(message)
+
+	slug := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == '_' {
+			return r
+		}
+
+		return '-'
+	}, subject)
+
+	for strings.Contains(slug, "--") {
+		slug = strings.Replace(slug, "--", "-", -1)
+	}
+
+	if len(slug) > maxPatchNameLength {
+		slug = slug[:maxPatchNameLength]
+	}
+
+	return fmt.Sprintf("%04d-%s.patch", n, strings.Trim(slug, "-."))
+}
@@ -0,0 +1,127 @@
+package git_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	libgit "gopkg.in/src-d/go-git.v4"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes/git"
+)
+
+var _ = Describe("formatting patches", func() {
+	var (
+		tmpDir   string
+		repoPath string
+		patchDir string
+		repo     git.Repo
+		base     string
+	)
+
+	commitFile := func(name string, content string, message string) {
+		p := filepath.Join(repoPath, name)
+		Expect(os.MkdirAll(filepath.Dir(p), os.ModePerm)).To(Succeed())
+		Expect(ioutil.WriteFile(p, []byte(content), 0644)).To(Succeed())
+		Expect(repo.Add(name)).To(Succeed())
+		Expect(repo.Commit("Jane Doe", "jane@example.com", message)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "kep-git-format-patch")
+		Expect(err).ToNot(HaveOccurred())
+
+		repoPath = filepath.Join(tmpDir, "repo")
+		patchDir = filepath.Join(tmpDir, "patches")
+
+		_, err = libgit.PlainInit(repoPath, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		repo, err = git.Open(repoPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		commitFile("README.md", "# Enhancements\n", "initial commit")
+
+		base, err = repo.Head()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(repo.Checkout("a-great-kep")).To(Succeed())
+		commitFile("keps/a-great-kep/metadata.yaml", "title: A Great KEP\n", "Add a great KEP\n\nThe KEP is great")
+		commitFile("README.md", "# Enhancements\n\n- a great KEP\n", "List the great KEP")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("writes the commits since a revision as numbered patches, oldest first", func() {
+		written, err := repo.FormatPatch(git.PatchOptions{Since: base, ToDir: patchDir})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(written).To(Equal([]string{
+			filepath.Join(patchDir, "0001-Add-a-great-KEP.patch"),
+			filepath.Join(patchDir, "0002-List-the-great-KEP.patch"),
+		}))
+
+		first, err := ioutil.ReadFile(written[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(first)).To(ContainSubstring("From: Jane Doe <jane@example.com>\n"))
+		Expect(string(first)).To(ContainSubstring("Subject: [PATCH 1/2] Add a great KEP\n\nThe KEP is great\n---\n"))
+		Expect(string(first)).To(ContainSubstring("+title: A Great KEP"))
+	})
+
+	It("introduces the series with a cover letter", func() {
+		written, err := repo.FormatPatch(git.PatchOptions{
+			Since:        base,
+			ToDir:        patchDir,
+			CoverSubject: "Propose KEP: A Great KEP",
+			CoverBody:    "Please review",
+			Name:         "Jane Doe",
+			Email:        "jane@example.com",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(written).To(HaveLen(3))
+		Expect(written[0]).To(Equal(filepath.Join(patchDir, git.CoverLetterFilename)))
+
+		cover, err := ioutil.ReadFile(written[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(cover)).To(ContainSubstring("Subject: [PATCH 0/2] Propose KEP: A Great KEP\n\nPlease review\n"))
+		Expect(string(cover)).To(ContainSubstring("  Add a great KEP\n  List the great KEP\n"))
+
+		By("writing files which are not executable")
+		for _, p := range written {
+			info, err := os.Stat(p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm() & 0111).To(BeZero(), p)
+		}
+	})
+
+	It("writes patches which `git am` applies", func() {
+		if _, err := exec.LookPath("git"); err != nil {
+			Skip("git unavailable and required for test")
+		}
+
+		written, err := repo.FormatPatch(git.PatchOptions{Since: base, ToDir: patchDir})
+		Expect(err).ToNot(HaveOccurred())
+
+		applyPath := filepath.Join(tmpDir, "apply")
+		_, err = libgit.PlainClone(applyPath, false, &libgit.CloneOptions{URL: repoPath, ReferenceName: "refs/heads/master"})
+		Expect(err).ToNot(HaveOccurred())
+
+		am := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com", "am"}, written...)...)
+		am.Dir = applyPath
+		out, err := am.CombinedOutput()
+		Expect(err).ToNot(HaveOccurred(), string(out))
+
+		readme, err := ioutil.ReadFile(filepath.Join(applyPath, "README.md"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.TrimSpace(string(readme))).To(HaveSuffix("- a great KEP"))
+
+		Expect(filepath.Join(applyPath, "keps", "a-great-kep", "metadata.yaml")).To(BeAnExistingFile())
+	})
+})
@@ -1,6 +1,91 @@
 package git
 
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	libgit "gopkg.in/src-d/go-git.v4"
+	libgitconfig "gopkg.in/src-d/go-git.v4/config"
+	libgitplumbing "gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	libgithttp "gopkg.in/src-d/go-git.v4/plumbing/transport/http"
+
+	"github.com/calebamiles/keps/pkg/changes/auth"
+)
+
 const (
 	OriginRemoteName   = "origin"
 	UpstreamRemoteName = "upstream"
 )
+
+// Fetch updates the remote tracking branches of remoteName. The token may be nil
+// for remotes which do not require authentication
+func (r *repository) Fetch(token auth.TokenProvider, remoteName string) error {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	remoteAuth, err := authMethod(token)
+	if err != nil {
+		return err
+	}
+
+	err = r.underlying.Fetch(&libgit.FetchOptions{
+		RemoteName: remoteName,
+		RefSpecs:   []libgitconfig.RefSpec{libgitconfig.RefSpec(fmt.Sprintf("+refs/heads/*:refs/remotes/%s/*", remoteName))},
+		Auth:       remoteAuth,
+	})
+
+	switch err {
+	case nil:
+		return nil
+	case libgit.NoErrAlreadyUpToDate:
+		return nil
+	default:
+		log.Errorf("fetching remote `%s`: %s", remoteName, err)
+		return err
+	}
+}
+
+// DefaultBranch returns the name of the branch the HEAD of remoteName refers to
+func (r *repository) DefaultBranch(token auth.TokenProvider, remoteName string) (string, error) {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+
+	remoteAuth, err := authMethod(token)
+	if err != nil {
+		return "", err
+	}
+
+	remote, err := r.underlying.Remote(remoteName)
+	if err != nil {
+		return "", err
+	}
+
+	refs, err := remote.List(&libgit.ListOptions{Auth: remoteAuth})
+	if err != nil {
+		log.Errorf("listing references of remote `%s`: %s", remoteName, err)
+		return "", err
+	}
+
+	for _, ref := range refs {
+		if ref.Name() == libgitplumbing.HEAD && ref.Type() == libgitplumbing.SymbolicReference {
+			return ref.Target().Short(), nil
+		}
+	}
+
+	return "", fmt.Errorf("could not determine the branch HEAD refers to on remote `%s`", remoteName)
+}
+
+// authMethod returns the credentials to use with a remote, or nil when no token is given
+func authMethod(token auth.TokenProvider) (transport.AuthMethod, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	authToken, err := token.Value()
+	if err != nil {
+		return nil, err
+	}
+
+	return &libgithttp.BasicAuth{Username: auth.ArbitraryUsername, Password: authToken}, nil
+}
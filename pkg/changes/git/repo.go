@@ -10,18 +10,28 @@ import (
 	libgitconfig "gopkg.in/src-d/go-git.v4/config"
 	libgitplumbing "gopkg.in/src-d/go-git.v4/plumbing"
 	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
-	libgithttp "gopkg.in/src-d/go-git.v4/plumbing/transport/http"
 
 	"github.com/calebamiles/keps/pkg/changes/auth"
 )
 
 type Repo interface {
 	SetOrigin(originLocation string) error
+	SetRemote(remoteName string, remoteLocation string) error
 
-	Checkout(branchName string) error // needed by the hermetic.Fork workflow
+	Fetch(token auth.TokenProvider, remoteName string) error
+	DefaultBranch(token auth.TokenProvider, remoteName string) (string, error)
+
+	Checkout(branchName string) error
+	CreateBranchFrom(remoteName string, remoteBranch string, branchName string) error
+	FastForward(remoteName string, remoteBranch string) error
 	Add(path string) error
 	Commit(name string, email string, message string) error
+	CommitWithOptions(message string, opts CommitOptions) error
 	PushOrigin(token auth.TokenProvider, localBranch string, remoteBranch string) error
+	Push(token auth.TokenProvider, remoteName string, localBranch string, remoteBranch string) error
+
+	Head() (string, error)
+	FormatPatch(opts PatchOptions) ([]string, error)
 }
 
 type repository struct {
@@ -32,21 +42,25 @@ type repository struct {
 }
 
 func (r *repository) SetOrigin(originLocation string) error {
+	return r.SetRemote(OriginRemoteName, originLocation)
+}
+
+func (r *repository) SetRemote(remoteName string, remoteLocation string) error {
 	r.locker.Lock()
 	defer r.locker.Unlock()
 
-	_, err := r.underlying.CreateRemote(&libgitconfig.RemoteConfig{Name: OriginRemoteName, URLs: []string{originLocation}})
+	_, err := r.underlying.CreateRemote(&libgitconfig.RemoteConfig{Name: remoteName, URLs: []string{remoteLocation}})
 	switch err {
 	case nil:
 		return nil
 
 	case libgit.ErrRemoteExists:
-		delErr := r.underlying.DeleteRemote(OriginRemoteName)
+		delErr := r.underlying.DeleteRemote(remoteName)
 		if delErr != nil {
 			return delErr
 		}
 
-		_, createErr := r.underlying.CreateRemote(&libgitconfig.RemoteConfig{Name: OriginRemoteName, URLs: []string{originLocation}})
+		_, createErr := r.underlying.CreateRemote(&libgitconfig.RemoteConfig{Name: remoteName, URLs: []string{remoteLocation}})
 		if createErr != nil {
 			return createErr
 		}
@@ -54,7 +68,7 @@ func (r *repository) SetOrigin(originLocation string) error {
 		return nil
 
 	default:
-		log.Errorf("creating `origin` remote with URL: %s due to error: %s", originLocation, err)
+		log.Errorf("creating `%s` remote with URL: %s due to error: %s", remoteName, remoteLocation, err)
 		return err
 	}
 }
@@ -69,26 +83,24 @@ func (r *repository) Checkout(branchName string) error {
 		return err
 	}
 
-	fullBranchName := fmt.Sprintf("refs/heads/%s", branchName)
-	err = worktree.Checkout(&libgit.CheckoutOptions{
-		Branch: libgitplumbing.ReferenceName(fullBranchName),
-	})
+	fullBranchName := libgitplumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", branchName))
+
+	head, headErr := r.underlying.Head()
+	if headErr == nil && head.Name() == fullBranchName {
+		return nil
+	}
 
+	_, err = r.underlying.Reference(fullBranchName, true)
 	switch err {
 	case libgitplumbing.ErrReferenceNotFound:
-		// TODO we should fetch upstream and create new branches from that in the future
-		headHash, err := r.underlying.ResolveRevision(libgitplumbing.Revision(DefaultBranchName))
-		if err != nil {
-			log.Errorf("resolving revision at HEAD: %s", err)
-			return err
+		// like `git checkout -b` new branches start from HEAD, CreateBranchFrom
+		// starts a branch from a remote branch instead
+		if headErr != nil {
+			log.Errorf("resolving HEAD: %s", headErr)
+			return fmt.Errorf("cannot create branch: %s without a commit at HEAD", branchName)
 		}
 
-		err = worktree.Checkout(&libgit.CheckoutOptions{
-			Branch: libgitplumbing.ReferenceName(fullBranchName),
-			Create: true,
-			Hash:   *headHash,
-		})
-
+		err = r.moveBranch(fullBranchName, head.Hash())
 		if err != nil {
 			log.Errorf("checking out newly created branch: %s with error: %s", branchName, err)
 			return err
@@ -96,7 +108,7 @@ func (r *repository) Checkout(branchName string) error {
 
 		// it really feels wrong to have to do this but the tests don't seem to be convinced that we can create a branch without doing this as well the the checkout.
 		// TODO fix this
-		err = r.underlying.CreateBranch(&libgitconfig.Branch{Name: branchName, Remote: OriginRemoteName, Merge: libgitplumbing.ReferenceName(fullBranchName)})
+		err = r.underlying.CreateBranch(&libgitconfig.Branch{Name: branchName, Remote: OriginRemoteName, Merge: fullBranchName})
 		if err != nil {
 			log.Errorf("creating branch: %s with error: %s", branchName, err)
 			return err
@@ -105,6 +117,12 @@ func (r *repository) Checkout(branchName string) error {
 		return nil
 
 	case nil:
+		err = worktree.Checkout(&libgit.CheckoutOptions{Branch: fullBranchName})
+		if err != nil {
+			log.Errorf("checking out branch: %s with error: %s", branchName, err)
+			return err
+		}
+
 		return nil
 
 	default:
@@ -133,6 +151,13 @@ func (r *repository) Add(p string) error {
 }
 
 func (r *repository) Commit(name string, email string, message string) error {
+	return r.CommitWithOptions(message, CommitOptions{Name: name, Email: email})
+}
+
+// CommitWithOptions commits staged changes as the author described by opts, adding any
+// requested trailers and signing the commit when opts.Signer is set. Signed commits
+// name the author as committer, unsigned commits are committed by the KEP tool
+func (r *repository) CommitWithOptions(message string, opts CommitOptions) error {
 	r.locker.Lock()
 	defer r.locker.Unlock()
 
@@ -152,19 +177,27 @@ func (r *repository) Commit(name string, email string, message string) error {
 		return nil
 	}
 
-	_, err = worktree.Commit(message, &libgit.CommitOptions{
-		Author: &libgitobject.Signature{
-			Name:  name,
-			Email: email,
-			When:  time.Now(),
-		},
+	now := time.Now()
+
+	author := &libgitobject.Signature{
+		Name:  opts.Name,
+		Email: opts.Email,
+		When:  now,
+	}
+
+	committer := &libgitobject.Signature{
+		Name:  "OSS KEP Tool",
+		Email: "kubernetes-sig-architecture@googlegroups.com",
+		When:  now,
+	}
+
+	if opts.Signer != nil {
+		committer = author
+	}
 
-		// TODO add test for this
-		Committer: &libgitobject.Signature{
-			Name:  "OSS KEP Tool",
-			Email: "kubernetes-sig-architecture@googlegroups.com",
-			When:  time.Now(),
-		},
+	hash, err := worktree.Commit(opts.message(message), &libgit.CommitOptions{
+		Author:    author,
+		Committer: committer,
 	})
 
 	if err != nil {
@@ -172,9 +205,68 @@ func (r *repository) Commit(name string, email string, message string) error {
 		return err
 	}
 
+	if opts.Signer == nil {
+		return nil
+	}
+
+	err = r.sign(hash, opts.Signer)
+	if err != nil {
+		log.Errorf("signing commit: %s", err)
+		return err
+	}
+
 	return nil
 }
 
+// sign replaces the commit at HEAD, identified by hash, with a signed copy
+func (r *repository) sign(hash libgitplumbing.Hash, signer Signer) error {
+	commit, err := r.underlying.CommitObject(hash)
+	if err != nil {
+		return err
+	}
+
+	unsigned := &libgitplumbing.MemoryObject{}
+	err = commit.Encode(unsigned)
+	if err != nil {
+		return err
+	}
+
+	content, err := unsigned.Reader()
+	if err != nil {
+		return err
+	}
+
+	defer content.Close()
+
+	commit.PGPSignature, err = signer.Sign(content)
+	if err != nil {
+		return err
+	}
+
+	signed := r.underlying.Storer.NewEncodedObject()
+	err = commit.Encode(signed)
+	if err != nil {
+		return err
+	}
+
+	signedHash, err := r.underlying.Storer.SetEncodedObject(signed)
+	if err != nil {
+		return err
+	}
+
+	head, err := r.underlying.Storer.Reference(libgitplumbing.HEAD)
+	if err != nil {
+		return err
+	}
+
+	name := libgitplumbing.HEAD
+	if head.Type() == libgitplumbing.SymbolicReference {
+		name = head.Target()
+	}
+
+	return r.underlying.Storer.SetReference(libgitplumbing.NewHashReference(name, signedHash))
+}
+
 func (r *repository) PushOrigin(token auth.TokenProvider, localBranch string, remoteBranch string) error {
 	return r.Push(token, OriginRemoteName, localBranch, remoteBranch)
 }
@@ -183,7 +275,7 @@ func (r *repository) Push(token auth.TokenProvider, remoteName string, localBran
 	r.locker.Lock()
 	defer r.locker.Unlock()
 
-	authToken, err := token.Value()
+	remoteAuth, err := authMethod(token)
 	if err != nil {
 		return err
 	}
@@ -207,10 +299,7 @@ func (r *repository) Push(token auth.TokenProvider, remoteName string, localBran
 	err = r.underlying.Push(&libgit.PushOptions{
 		RefSpecs:   []libgitconfig.RefSpec{libgitconfig.RefSpec(fmt.Sprintf("+refs/heads/%s:refs/heads/%s", localBranch, remoteBranch))},
 		RemoteName: remoteName,
-		Auth: &libgithttp.BasicAuth{
-			Username: auth.ArbitraryUsername,
-			Password: authToken,
-		},
+		Auth:       remoteAuth,
 	})
 
 	switch err {
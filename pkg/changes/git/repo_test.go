@@ -123,8 +123,11 @@ var _ = Describe("working with a Git repository", func() {
 			repo, err := git.Clone(token, exampleRepoUrl, exampleRepoLocation)
 			Expect(err).ToNot(HaveOccurred(), "expected no error when cloning a repository with a valid token and nonexistent location")
 
-			err = repo.Checkout(git.DefaultBranchName)
-			Expect(err).ToNot(HaveOccurred(), "expected no error when checking out the existing `master` branch")
+			defaultBranch, err := repo.DefaultBranch(token, git.OriginRemoteName)
+			Expect(err).ToNot(HaveOccurred(), "expected no error when discovering the default branch")
+
+			err = repo.Checkout(defaultBranch)
+			Expect(err).ToNot(HaveOccurred(), "expected no error when checking out the existing default branch")
 		})
 
 		Context("when the branch does not already exist", func() {
@@ -474,7 +477,10 @@ var _ = Describe("working with a Git repository", func() {
 				repo, err := git.Clone(token, exampleRepoUrl, exampleRepoLocation)
 				Expect(err).ToNot(HaveOccurred(), "expected no error when cloning a repository with a valid token and nonexistent location")
 
-				err = repo.PushOrigin(token, git.DefaultBranchName, git.DefaultBranchName)
+				defaultBranch, err := repo.DefaultBranch(token, git.OriginRemoteName)
+				Expect(err).ToNot(HaveOccurred(), "expected no error when discovering the default branch")
+
+				err = repo.PushOrigin(token, defaultBranch, defaultBranch)
 				Expect(err).ToNot(HaveOccurred(), "expected no error when pushing no new changes to an existing remote branch")
 			})
 		})
@@ -0,0 +1,84 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// A Signer returns an armored detached signature of a commit's content
+type Signer interface {
+	Sign(content io.Reader) (string, error)
+}
+
+// NewGPGSigner returns a Signer using the first key of the armored, unencrypted,
+// OpenPGP private key ring at keyPath
+func NewGPGSigner(keyPath string) (Signer, error) {
+	f, err := os.Open(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	keyRing, err := openpgp.ReadArmoredKeyRing(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keyRing) == 0 || keyRing[0].PrivateKey == nil {
+		return nil, fmt.Errorf("no private key found at: %s", keyPath)
+	}
+
+	if keyRing[0].PrivateKey.Encrypted {
+		return nil, fmt.Errorf("private key at: %s is encrypted, only unencrypted keys are supported", keyPath)
+	}
+
+	return &gpgSigner{entity: keyRing[0]}, nil
+}
+
+type gpgSigner struct {
+	entity *openpgp.Entity
+}
+
+func (s *gpgSigner) Sign(content io.Reader) (string, error) {
+	var signature bytes.Buffer
+
+	err := openpgp.ArmoredDetachSign(&signature, s.entity, content, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return signature.String(), nil
+}
+
+// NewSSHSigner returns a Signer using `ssh-keygen -Y sign` with the SSH key at
+// keyPath (or its public half when the private key is held by an agent)
+func NewSSHSigner(keyPath string) Signer {
+	return &sshSigner{keyPath: keyPath}
+}
+
+type sshSigner struct {
+	keyPath string
+}
+
+func (s *sshSigner) Sign(content io.Reader) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("ssh-keygen", "-Y", "sign", "-n", "git", "-f", s.keyPath)
+	cmd.Stdin = content
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("signing with `ssh-keygen`: %s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/calebamiles/keps/pkg/changes/auth"
+)
+
+const (
+	// appJWTLifetime is the longest lifetime GitHub accepts (10 minutes) minus some room for clock drift
+	appJWTLifetime = 9 * time.Minute
+
+	// installationTokenRefreshMargin is how long before expiry a cached installation token is replaced
+	installationTokenRefreshMargin = time.Minute
+)
+
+// AppTokenProvider is an auth.TokenProvider of installation tokens for a
+// GitHub App, allowing automation to act as the App rather than as a user.
+// Tokens are cached until shortly before they expire
+type AppTokenProvider struct {
+	AppID          int64
+	InstallationID int64
+	Options        Options
+	HTTPClient     *http.Client
+
+	key *rsa.PrivateKey
+	now func() time.Time
+
+	locker    sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewAppTokenProvider returns an AppTokenProvider exchanging a JWT signed with
+// the App's PEM encoded private key for an installation token from the GitHub
+// described by opts
+func NewAppTokenProvider(appID int64, installationID int64, privateKey []byte, opts Options) (*AppTokenProvider, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &AppTokenProvider{
+		AppID:          appID,
+		InstallationID: installationID,
+		Options:        opts,
+		HTTPClient:     HttpClient(),
+		key:            key,
+		now:            time.Now,
+	}
+
+	return t, nil
+}
+
+// NewAppTokenProviderFromKeyPath is NewAppTokenProvider with the private key read from p
+func NewAppTokenProviderFromKeyPath(appID int64, installationID int64, p string, opts Options) (*AppTokenProvider, error) {
+	keyBytes, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAppTokenProvider(appID, installationID, keyBytes, opts)
+}
+
+func (t *AppTokenProvider) Value() (string, error) {
+	t.locker.Lock()
+	defer t.locker.Unlock()
+
+	if t.token != "" && t.now().Add(installationTokenRefreshMargin).Before(t.expiresAt) {
+		return t.token, nil
+	}
+
+	appJWT, err := t.signJWT()
+	if err != nil {
+		return "", err
+	}
+
+	apiUrl := t.Options.InstallationTokenUrl(t.InstallationID)
+
+	req, err := http.NewRequest(http.MethodPost, apiUrl, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set(AuthorizationHeaderName, fmt.Sprintf("Bearer %s", appJWT))
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+
+	resp, err := t.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("expected status code 201 Created when creating installation token, got: %s.\nURL: %s.\nBody: %s", resp.Status, apiUrl, string(bodyBytes))
+	}
+
+	var tokenResponse struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	err = json.Unmarshal(bodyBytes, &tokenResponse)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := auth.Validate(tokenResponse.Token)
+	if err != nil {
+		return "", err
+	}
+
+	t.token = token
+	t.expiresAt = tokenResponse.ExpiresAt
+
+	return t.token, nil
+}
+
+// signJWT returns a RS256 signed JWT identifying the App
+func (t *AppTokenProvider) signJWT() (string, error) {
+	now := t.now()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]int64{
+		"iat": now.Add(-time.Minute).Unix(), // allow for clock drift
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": t.AppID,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, t.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parsePrivateKey accepts PKCS#1 keys, as downloaded from GitHub, and PKCS#8 keys
+func parsePrivateKey(privateKey []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, errors.New("GitHub App private key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing GitHub App private key: %s", err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GitHub App private key is not an RSA key")
+	}
+
+	return key, nil
+}
@@ -0,0 +1,332 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/calebamiles/keps/pkg/changes/auth"
+)
+
+const (
+	// DefaultUserAgent is sent with every request made by a Client
+	DefaultUserAgent = "kep-tool"
+
+	// DefaultForkTimeout bounds waiting for GitHub to finish creating a fork
+	DefaultForkTimeout = 5 * time.Minute
+
+	// DefaultPollInterval is the wait between checks of whether a fork can be cloned
+	DefaultPollInterval = 2 * time.Second
+)
+
+// Client issues requests against the GitHub API on behalf of the holder of Token
+type Client struct {
+	Options    Options
+	Token      auth.TokenProvider
+	HTTPClient *http.Client
+	UserAgent  string
+
+	// PollInterval is the wait between checks of whether a newly created fork can be cloned
+	PollInterval time.Duration
+}
+
+// NewClient returns a Client for the GitHub described by opts
+func NewClient(token auth.TokenProvider, opts Options) *Client {
+	return &Client{
+		Options:      opts,
+		Token:        token,
+		HTTPClient:   HttpClient(),
+		UserAgent:    DefaultUserAgent,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// PullRequest is the subset of a GitHub pull request used by the KEP tooling
+type PullRequest struct {
+	NodeId  string `json:"node_id"`
+	Number  int    `json:"number"`
+	HtmlUrl string `json:"html_url"`
+	State   string `json:"state"`
+	Title   string `json:"title"`
+	Merged  bool   `json:"merged"`
+}
+
+// ListPullRequestsOptions filter the pull requests returned by ListPRs. The
+// zero value lists open pull requests
+type ListPullRequestsOptions struct {
+	State string // open, closed or all
+	Head  string // owner:branch
+	Base  string
+}
+
+// Fork forks owner/repo into the account of the authenticated user, returning
+// the HTML URL of the fork. GitHub creates forks asynchronously so Fork waits,
+// until ctx is done or DefaultForkTimeout has passed, for the fork to be cloneable
+func (c *Client) Fork(ctx context.Context, owner string, repo string) (string, error) {
+	var forkResponse struct {
+		NodeIdField  string `json:"node_id"`
+		HtmlUrlField string `json:"html_url"`
+		ApiUrlField  string `json:"url"`
+		NameField    string `json:"name"`
+		OwnerField   struct {
+			LoginField string `json:"login"`
+		} `json:"owner"`
+	}
+
+	err := c.do(ctx, http.MethodPost, c.Options.ForkUrl(owner, repo), nil, http.StatusAccepted, &forkResponse)
+	if err != nil {
+		return "", err
+	}
+
+	if forkResponse.ApiUrlField == "" {
+		return "", fmt.Errorf("recieved empty API url from response when forking %s/%s", owner, repo)
+	}
+
+	if forkResponse.HtmlUrlField == "" {
+		return "", fmt.Errorf("recieved empty Git url from response when forking %s/%s", owner, repo)
+	}
+
+	if forkResponse.OwnerField.LoginField != "" && forkResponse.NameField != "" {
+		err = c.waitUntilCloneable(ctx, forkResponse.OwnerField.LoginField, forkResponse.NameField)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return forkResponse.HtmlUrlField, nil
+}
+
+// waitUntilCloneable polls owner/repo until it has commits, GitHub responds
+// with 404 Not Found while a fork is being created and 409 Conflict while
+// the fork is still empty
+func (c *Client) waitUntilCloneable(ctx context.Context, owner string, repo string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultForkTimeout)
+		defer cancel()
+	}
+
+	apiUrl := fmt.Sprintf("%s/commits?per_page=1", c.Options.RepoApiUrl(owner, repo))
+
+	for {
+		err := c.do(ctx, http.MethodGet, apiUrl, nil, http.StatusOK, nil)
+		switch e := err.(type) {
+		case nil:
+			return nil
+		case *Error:
+			if e.StatusCode != http.StatusNotFound && e.StatusCode != http.StatusConflict {
+				return err
+			}
+		default:
+			return err
+		}
+
+		timer := time.NewTimer(c.PollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("waiting for fork %s/%s to become available: %s", owner, repo, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// CreatePR opens a pull request described by routingInfo
+func (c *Client) CreatePR(ctx context.Context, routingInfo PullRequestRoutingInfo, prTitle PullRequestTitle, prDescription PullRequestDescription) (*PullRequest, error) {
+	var createPrPayload struct {
+		TitleField          PullRequestTitle       `json:"title"`
+		DescriptionField    PullRequestDescription `json:"body,omitempty"`
+		SourceBranchField   string                 `json:"head"`
+		TargetBranchField   string                 `json:"base"`
+		MaintainerCanModify bool                   `json:"maintainer_can_modify,omitempty"`
+	}
+
+	createPrPayload.TitleField = prTitle
+	createPrPayload.DescriptionField = prDescription
+	createPrPayload.TargetBranchField = routingInfo.TargetBranch()
+	createPrPayload.SourceBranchField = fmt.Sprintf("%s:%s", routingInfo.SourceRepositoryOwner(), routingInfo.SourceBranch())
+	createPrPayload.MaintainerCanModify = true
+
+	apiUrl := c.Options.PrUrl(routingInfo.TargetRepositoryOwner(), routingInfo.TargetRepository())
+
+	pr := &PullRequest{}
+	err := c.do(ctx, http.MethodPost, apiUrl, createPrPayload, http.StatusCreated, pr)
+	if githubErr, ok := err.(*Error); ok && githubErr.PullRequestExists() {
+		return c.existingPR(ctx, routingInfo, githubErr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// existingPR returns the open pull request GitHub refused to duplicate
+func (c *Client) existingPR(ctx context.Context, routingInfo PullRequestRoutingInfo, createErr *Error) (*PullRequest, error) {
+	prs, err := c.ListPRs(ctx, routingInfo.TargetRepositoryOwner(), routingInfo.TargetRepository(), ListPullRequestsOptions{
+		Head: fmt.Sprintf("%s:%s", routingInfo.SourceRepositoryOwner(), routingInfo.SourceBranch()),
+		Base: routingInfo.TargetBranch(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(prs) == 0 {
+		return nil, createErr
+	}
+
+	return &prs[0], nil
+}
+
+// GetPR returns pull request number of owner/repo
+func (c *Client) GetPR(ctx context.Context, owner string, repo string, number int) (*PullRequest, error) {
+	apiUrl := fmt.Sprintf("%s/%d", c.Options.PrUrl(owner, repo), number)
+
+	pr := &PullRequest{}
+	err := c.do(ctx, http.MethodGet, apiUrl, nil, http.StatusOK, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// ListPRs returns the first page of pull requests of owner/repo matching opts
+func (c *Client) ListPRs(ctx context.Context, owner string, repo string, opts ListPullRequestsOptions) ([]PullRequest, error) {
+	req, err := http.NewRequest(http.MethodGet, c.Options.PrUrl(owner, repo), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	if opts.State != "" {
+		query.Set("state", opts.State)
+	}
+
+	if opts.Head != "" {
+		query.Set("head", opts.Head)
+	}
+
+	if opts.Base != "" {
+		query.Set("base", opts.Base)
+	}
+
+	req.URL.RawQuery = query.Encode()
+
+	prs := []PullRequest{}
+	err = c.do(ctx, http.MethodGet, req.URL.String(), nil, http.StatusOK, &prs)
+	if err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
+
+// DeleteRepo deletes owner/repo, most likely a fork owned by the authenticated user
+func (c *Client) DeleteRepo(ctx context.Context, owner string, repo string) error {
+	return c.do(ctx, http.MethodDelete, c.Options.RepoApiUrl(owner, repo), nil, http.StatusNoContent, nil)
+}
+
+// AddLabels adds labels to pull request (or issue) number of owner/repo
+func (c *Client) AddLabels(ctx context.Context, owner string, repo string, number int, labels ...string) error {
+	apiUrl := fmt.Sprintf("%s/issues/%d/labels", c.Options.RepoApiUrl(owner, repo), number)
+
+	var addLabelsPayload struct {
+		Labels []string `json:"labels"`
+	}
+
+	addLabelsPayload.Labels = labels
+
+	return c.do(ctx, http.MethodPost, apiUrl, addLabelsPayload, http.StatusOK, nil)
+}
+
+// RequestReviewers requests reviews of pull request number of owner/repo from reviewers
+func (c *Client) RequestReviewers(ctx context.Context, owner string, repo string, number int, reviewers ...string) error {
+	apiUrl := fmt.Sprintf("%s/%d/requested_reviewers", c.Options.PrUrl(owner, repo), number)
+
+	var requestReviewersPayload struct {
+		Reviewers []string `json:"reviewers"`
+	}
+
+	requestReviewersPayload.Reviewers = reviewers
+
+	return c.do(ctx, http.MethodPost, apiUrl, requestReviewersPayload, http.StatusCreated, nil)
+}
+
+// do issues a request with an optional JSON payload, returning an *Error unless
+// the response has expectedStatus. When result is not nil the response body is
+// decoded into it
+func (c *Client) do(ctx context.Context, method string, apiUrl string, payload interface{}, expectedStatus int, result interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(payloadBytes)
+	}
+
+	req, err := http.NewRequest(method, apiUrl, body)
+	if err != nil {
+		return err
+	}
+
+	if c.Token != nil {
+		err = AddAuthorizationHeader(req, c.Token)
+		if err != nil {
+			return err
+		}
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = HttpClient()
+	}
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return newError(method, apiUrl, resp, bodyBytes)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(bodyBytes, result)
+}
@@ -0,0 +1,277 @@
+package github_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes/auth/authfakes"
+	"github.com/calebamiles/keps/pkg/changes/github/githubfakes"
+
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+var _ = Describe("Client", func() {
+	type recordedRequest struct {
+		method string
+		path   string
+		query  string
+		header http.Header
+		body   map[string]interface{}
+	}
+
+	var (
+		server   *httptest.Server
+		client   *github.Client
+		requests []recordedRequest
+
+		responseStatus int
+		responseBody   string
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		responseStatus = http.StatusOK
+		responseBody = "{}"
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			recorded := recordedRequest{
+				method: r.Method,
+				path:   r.URL.Path,
+				query:  r.URL.RawQuery,
+				header: r.Header,
+			}
+
+			json.NewDecoder(r.Body).Decode(&recorded.body)
+			requests = append(requests, recorded)
+
+			w.WriteHeader(responseStatus)
+			w.Write([]byte(responseBody))
+		}))
+
+		token := &authfakes.FakeTokenProvider{}
+		token.ValueReturns("some-token", nil)
+
+		client = github.NewClient(token, github.Options{ApiUrl: server.URL})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("authorizes and identifies requests", func() {
+		client.UserAgent = "kep-tool-test"
+
+		_, err := client.GetPR(context.Background(), "kubernetes", "enhancements", 1)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].header.Get("Authorization")).To(Equal("token some-token"))
+		Expect(requests[0].header.Get("User-Agent")).To(Equal("kep-tool-test"))
+	})
+
+	Describe("Fork()", func() {
+		It("forks the repository and returns the location of the fork", func() {
+			responseStatus = http.StatusAccepted
+			responseBody = `{"url": "https://api.github.com/repos/handle/enhancements", "html_url": "https://github.com/handle/enhancements"}`
+
+			forkUrl, err := client.Fork(context.Background(), "kubernetes", "enhancements")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(forkUrl).To(Equal("https://github.com/handle/enhancements"))
+
+			Expect(requests[0].method).To(Equal(http.MethodPost))
+			Expect(requests[0].path).To(Equal("/repos/kubernetes/enhancements/forks"))
+		})
+
+		It("waits for GitHub to finish creating the fork", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, recordedRequest{method: r.Method, path: r.URL.Path})
+
+				switch {
+				case r.Method == http.MethodPost:
+					w.WriteHeader(http.StatusAccepted)
+					w.Write([]byte(`{"url": "https://api.github.com/repos/handle/enhancements", "html_url": "https://github.com/handle/enhancements", "name": "enhancements", "owner": {"login": "handle"}}`))
+				case len(requests) < 4:
+					w.WriteHeader(http.StatusConflict)
+					w.Write([]byte(`{"message": "Git Repository is empty."}`))
+				default:
+					w.Write([]byte(`[{"sha": "abc123"}]`))
+				}
+			})
+
+			client.PollInterval = time.Millisecond
+
+			forkUrl, err := client.Fork(context.Background(), "kubernetes", "enhancements")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(forkUrl).To(Equal("https://github.com/handle/enhancements"))
+
+			Expect(requests).To(HaveLen(4))
+			Expect(requests[3].path).To(Equal("/repos/handle/enhancements/commits"))
+		})
+	})
+
+	Describe("CreatePR()", func() {
+		It("opens a pull request from the source branch", func() {
+			responseStatus = http.StatusCreated
+			responseBody = `{"number": 42, "html_url": "https://github.com/kubernetes/enhancements/pull/42", "state": "open"}`
+
+			routingInfo := &githubfakes.FakePullRequestRoutingInfo{}
+			routingInfo.SourceRepositoryOwnerReturns("handle")
+			routingInfo.SourceBranchReturns("a-great-kep")
+			routingInfo.TargetRepositoryOwnerReturns("kubernetes")
+			routingInfo.TargetRepositoryReturns("enhancements")
+			routingInfo.TargetBranchReturns("master")
+
+			pr, err := client.CreatePR(context.Background(), routingInfo, "A Great KEP", "Please review")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Number).To(Equal(42))
+			Expect(pr.HtmlUrl).To(Equal("https://github.com/kubernetes/enhancements/pull/42"))
+
+			Expect(requests[0].path).To(Equal("/repos/kubernetes/enhancements/pulls"))
+			Expect(requests[0].body).To(HaveKeyWithValue("head", "handle:a-great-kep"))
+			Expect(requests[0].body).To(HaveKeyWithValue("base", "master"))
+			Expect(requests[0].body).To(HaveKeyWithValue("title", "A Great KEP"))
+		})
+
+		Context("when GitHub rejects the pull request", func() {
+			var routingInfo *githubfakes.FakePullRequestRoutingInfo
+
+			BeforeEach(func() {
+				routingInfo = &githubfakes.FakePullRequestRoutingInfo{}
+				routingInfo.SourceRepositoryOwnerReturns("handle")
+				routingInfo.SourceBranchReturns("a-great-kep")
+				routingInfo.TargetRepositoryOwnerReturns("kubernetes")
+				routingInfo.TargetRepositoryReturns("enhancements")
+				routingInfo.TargetBranchReturns("master")
+			})
+
+			It("returns GitHub's message and validation errors", func() {
+				responseStatus = http.StatusUnprocessableEntity
+				responseBody = `{"message": "Validation Failed", "errors": [{"resource": "PullRequest", "field": "head", "code": "invalid"}]}`
+
+				_, err := client.CreatePR(context.Background(), routingInfo, "A Great KEP", "Please review")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Validation Failed"))
+				Expect(err.Error()).To(ContainSubstring("PullRequest head: invalid"))
+
+				githubErr, ok := err.(*github.Error)
+				Expect(ok).To(BeTrue(), "expected a *github.Error")
+				Expect(githubErr.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+				Expect(githubErr.Message).To(Equal("Validation Failed"))
+				Expect(githubErr.Errors).To(HaveLen(1))
+				Expect(githubErr.PullRequestExists()).To(BeFalse())
+			})
+
+			It("returns the existing pull request when one is already open", func() {
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests = append(requests, recordedRequest{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery})
+
+					switch r.Method {
+					case http.MethodPost:
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						w.Write([]byte(`{"message": "Validation Failed", "errors": [{"resource": "PullRequest", "code": "custom", "message": "A pull request already exists for handle:a-great-kep."}]}`))
+					default:
+						w.Write([]byte(`[{"number": 7, "html_url": "https://github.com/kubernetes/enhancements/pull/7", "state": "open"}]`))
+					}
+				})
+
+				pr, err := client.CreatePR(context.Background(), routingInfo, "A Great KEP", "Please review")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pr.HtmlUrl).To(Equal("https://github.com/kubernetes/enhancements/pull/7"))
+
+				Expect(requests).To(HaveLen(2))
+				Expect(requests[1].query).To(Equal("base=master&head=handle%3Aa-great-kep"))
+			})
+
+			It("returns an error when GitHub cannot be reached", func() {
+				server.Close()
+				client.HTTPClient = &http.Client{} // skip retrying
+
+				pr, err := client.CreatePR(context.Background(), routingInfo, "A Great KEP", "Please review")
+				Expect(err).To(HaveOccurred())
+				Expect(pr).To(BeNil())
+			})
+		})
+	})
+
+	Describe("GetPR()", func() {
+		It("returns the pull request", func() {
+			responseBody = `{"number": 42, "state": "closed", "merged": true}`
+
+			pr, err := client.GetPR(context.Background(), "kubernetes", "enhancements", 42)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.State).To(Equal("closed"))
+			Expect(pr.Merged).To(BeTrue())
+
+			Expect(requests[0].path).To(Equal("/repos/kubernetes/enhancements/pulls/42"))
+		})
+	})
+
+	Describe("ListPRs()", func() {
+		It("filters pull requests", func() {
+			responseBody = `[{"number": 1}, {"number": 2}]`
+
+			prs, err := client.ListPRs(context.Background(), "kubernetes", "enhancements", github.ListPullRequestsOptions{State: "all", Head: "handle:a-great-kep"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(prs).To(HaveLen(2))
+
+			Expect(requests[0].path).To(Equal("/repos/kubernetes/enhancements/pulls"))
+			Expect(requests[0].query).To(Equal("head=handle%3Aa-great-kep&state=all"))
+		})
+	})
+
+	Describe("DeleteRepo()", func() {
+		It("deletes the repository", func() {
+			responseStatus = http.StatusNoContent
+			responseBody = ""
+
+			err := client.DeleteRepo(context.Background(), "handle", "enhancements")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].method).To(Equal(http.MethodDelete))
+			Expect(requests[0].path).To(Equal("/repos/handle/enhancements"))
+		})
+	})
+
+	Describe("AddLabels()", func() {
+		It("labels the pull request", func() {
+			err := client.AddLabels(context.Background(), "kubernetes", "enhancements", 42, "kind/kep", "sig/architecture")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].path).To(Equal("/repos/kubernetes/enhancements/issues/42/labels"))
+			Expect(requests[0].body).To(HaveKeyWithValue("labels", ConsistOf("kind/kep", "sig/architecture")))
+		})
+	})
+
+	Describe("RequestReviewers()", func() {
+		It("requests reviews of the pull request", func() {
+			responseStatus = http.StatusCreated
+
+			err := client.RequestReviewers(context.Background(), "kubernetes", "enhancements", 42, "reviewerOne")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].path).To(Equal("/repos/kubernetes/enhancements/pulls/42/requested_reviewers"))
+			Expect(requests[0].body).To(HaveKeyWithValue("reviewers", ConsistOf("reviewerOne")))
+		})
+	})
+
+	Context("when GitHub responds with an unexpected status", func() {
+		It("returns an error including the status and body of the response", func() {
+			responseStatus = http.StatusNotFound
+			responseBody = `{"message": "Not Found"}`
+
+			_, err := client.GetPR(context.Background(), "kubernetes", "enhancements", 42)
+			Expect(err).To(HaveOccurred())
+
+			githubErr, ok := err.(*github.Error)
+			Expect(ok).To(BeTrue(), "expected a *github.Error")
+			Expect(githubErr.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(githubErr.Body).To(ContainSubstring("Not Found"))
+		})
+	})
+})
@@ -0,0 +1,12 @@
+package github
+
+import (
+	"context"
+
+	"github.com/calebamiles/keps/pkg/changes/auth"
+)
+
+// DeleteRepository deletes a GitHub repository, most likely a fork owned by the authenticated user
+func DeleteRepository(token auth.TokenProvider, owner string, repo string) error {
+	return NewClient(token, DefaultOptions()).DeleteRepo(context.Background(), owner, repo)
+}
@@ -0,0 +1,98 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error is returned when the GitHub API responds with an unexpected status
+type Error struct {
+	Method     string
+	Url        string
+	StatusCode int
+	Status     string
+	Body       string
+
+	// Message and Errors are parsed from GitHub's error response, when present
+	Message          string
+	Errors           []ValidationError
+	DocumentationUrl string
+}
+
+// ValidationError describes why GitHub rejected a request with 422 Unprocessable Entity
+type ValidationError struct {
+	Resource string `json:"resource"`
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+}
+
+func (v ValidationError) String() string {
+	if v.Message != "" {
+		return v.Message
+	}
+
+	return fmt.Sprintf("%s %s: %s", v.Resource, v.Field, v.Code)
+}
+
+func newError(method string, apiUrl string, resp *http.Response, body []byte) *Error {
+	e := &Error{
+		Method:     method,
+		Url:        apiUrl,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+	}
+
+	var errorResponse struct {
+		Message          string            `json:"message"`
+		Errors           []ValidationError `json:"errors"`
+		DocumentationUrl string            `json:"documentation_url"`
+	}
+
+	// not every error response is JSON, the raw body is kept regardless
+	if json.Unmarshal(body, &errorResponse) == nil {
+		e.Message = errorResponse.Message
+		e.Errors = errorResponse.Errors
+		e.DocumentationUrl = errorResponse.DocumentationUrl
+	}
+
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("%s %s: unexpected response status: %s.\nBody: %s", e.Method, e.Url, e.Status, e.Body)
+	}
+
+	msg := fmt.Sprintf("%s %s: %s: %s", e.Method, e.Url, e.Status, e.Message)
+
+	validationErrors := []string{}
+	for _, v := range e.Errors {
+		validationErrors = append(validationErrors, v.String())
+	}
+
+	if len(validationErrors) > 0 {
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(validationErrors, "; "))
+	}
+
+	return msg
+}
+
+// PullRequestExists is true when GitHub refused to create a pull request
+// because one is already open for the same head and base
+func (e *Error) PullRequestExists() bool {
+	if e.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+
+	for _, v := range e.Errors {
+		if strings.Contains(v.Message, "A pull request already exists") {
+			return true
+		}
+	}
+
+	return false
+}
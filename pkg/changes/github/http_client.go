@@ -4,8 +4,9 @@ import (
 	"net/http"
 )
 
-var httpClient = http.DefaultClient
+var httpClient = &http.Client{Transport: NewTransport(http.DefaultTransport)}
 
+// HttpClient returns the default rate limit aware, retrying, client for GitHub requests
 func HttpClient() *http.Client {
 	return httpClient
 }
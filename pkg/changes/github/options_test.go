@@ -0,0 +1,53 @@
+package github_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+var _ = Describe("Options", func() {
+	It("defaults to public GitHub", func() {
+		opts := github.Options{}
+
+		Expect(opts.RepoGitUrl("octocat", "Hello-World")).To(Equal("https://github.com/octocat/Hello-World.git"))
+		Expect(opts.ForkUrl("octocat", "Hello-World")).To(Equal("https://api.github.com/repos/octocat/Hello-World/forks"))
+		Expect(opts.PrUrl("octocat", "Hello-World")).To(Equal("https://api.github.com/repos/octocat/Hello-World/pulls"))
+		Expect(opts.RepoApiUrl("octocat", "Hello-World")).To(Equal("https://api.github.com/repos/octocat/Hello-World"))
+	})
+
+	It("supports GitHub Enterprise style locations", func() {
+		opts := github.Options{
+			ApiUrl: "https://github.example.com/api/v3/",
+			GitUrl: "https://github.example.com/",
+		}
+
+		Expect(opts.RepoGitUrl("octocat", "Hello-World")).To(Equal("https://github.example.com/octocat/Hello-World.git"))
+		Expect(opts.PrUrl("octocat", "Hello-World")).To(Equal("https://github.example.com/api/v3/repos/octocat/Hello-World/pulls"))
+	})
+
+	It("reads overrides from the environment", func() {
+		defer os.Setenv(github.ApiUrlEnv, os.Getenv(github.ApiUrlEnv))
+		defer os.Setenv(github.GitUrlEnv, os.Getenv(github.GitUrlEnv))
+
+		os.Setenv(github.ApiUrlEnv, "http://127.0.0.1:8080")
+		os.Setenv(github.GitUrlEnv, "http://127.0.0.1:9090")
+
+		Expect(github.PrUrl("octocat", "Hello-World")).To(Equal("http://127.0.0.1:8080/repos/octocat/Hello-World/pulls"))
+		Expect(github.GitUrl("octocat", "Hello-World")).To(Equal("http://127.0.0.1:9090/octocat/Hello-World.git"))
+	})
+
+	It("parses pull request URLs", func() {
+		owner, repo, number, err := github.ParsePullRequestUrl("https://github.example.com/octocat/Hello-World/pull/42")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(owner).To(Equal("octocat"))
+		Expect(repo).To(Equal("Hello-World"))
+		Expect(number).To(Equal(42))
+
+		_, _, _, err = github.ParsePullRequestUrl("https://github.com/octocat/Hello-World/issues/42")
+		Expect(err).To(HaveOccurred())
+	})
+})
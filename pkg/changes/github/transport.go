@@ -0,0 +1,343 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a request is retried before giving up
+	DefaultMaxRetries = 4
+
+	// DefaultMinBackoff is the wait before the first retry when GitHub doesn't specify one
+	DefaultMinBackoff = 500 * time.Millisecond
+
+	// DefaultMaxBackoff bounds any single wait, including waiting for a rate limit to reset
+	DefaultMaxBackoff = 60 * time.Second
+
+	// DefaultAttemptTimeout bounds each attempt at a request, not the waits between attempts
+	DefaultAttemptTimeout = 7000 * time.Millisecond
+
+	// DefaultMaxCachedResponses bounds the number of responses kept for conditional requests
+	DefaultMaxCachedResponses = 128
+
+	rateLimitRemainingHeaderName = "X-RateLimit-Remaining"
+	rateLimitResetHeaderName     = "X-RateLimit-Reset"
+	retryAfterHeaderName         = "Retry-After"
+	etagHeaderName               = "ETag"
+	ifNoneMatchHeaderName        = "If-None-Match"
+)
+
+// Transport is an http.RoundTripper which retries requests that GitHub rejected
+// due to (secondary) rate limiting, waiting as instructed by GitHub or backing
+// off exponentially with jitter. Idempotent requests are also retried when
+// GitHub failed to serve them (5xx) or could not be reached, other requests may
+// have been applied so are not. Each attempt is bounded by AttemptTimeout.
+// Successful GET responses carrying an ETag are remembered and revalidated with
+// conditional requests, which do not count against the rate limit
+type Transport struct {
+	Base           http.RoundTripper
+	MaxRetries     int
+	MinBackoff     time.Duration
+	MaxBackoff     time.Duration
+	AttemptTimeout time.Duration
+	MaxCached      int
+
+	locker      sync.Mutex
+	cached      map[string]*cachedResponse
+	cachedOrder []string // oldest first
+}
+
+type cachedResponse struct {
+	etag   string
+	status string
+	code   int
+	header http.Header
+	body   []byte
+}
+
+// NewTransport returns a Transport wrapping base with the default retry policy
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{
+		Base:           base,
+		MaxRetries:     DefaultMaxRetries,
+		MinBackoff:     DefaultMinBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+		AttemptTimeout: DefaultAttemptTimeout,
+		MaxCached:      DefaultMaxCachedResponses,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var payload []byte
+	if req.Body != nil {
+		var err error
+		payload, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cacheKey := req.Method + " " + req.URL.String() + " " + req.Header.Get(AuthorizationHeaderName)
+	cached := t.lookup(req, cacheKey)
+
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := t.attemptContext(req.Context())
+
+		attemptReq := req.Clone(attemptCtx)
+		if payload != nil {
+			attemptReq.Body = ioutil.NopCloser(bytes.NewReader(payload))
+			attemptReq.ContentLength = int64(len(payload))
+		}
+
+		if cached != nil {
+			attemptReq.Header.Set(ifNoneMatchHeaderName, cached.etag)
+		}
+
+		resp, err := t.base().RoundTrip(attemptReq)
+
+		wait, retry := t.shouldRetry(req.Method, resp, err, attempt)
+		if !retry || attempt >= t.MaxRetries || req.Context().Err() != nil {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+
+			// the attempt lasts until the response body has been read
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+			return t.remember(req, cacheKey, cached, resp)
+		}
+
+		if resp != nil {
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+
+		cancel()
+
+		log.Debugf("retrying %s %s in %s", req.Method, req.URL, wait)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry determines whether a request should be retried, and after how
+// long. Requests which are not idempotent are only retried when GitHub has
+// refused them due to rate limiting, as otherwise they may have been applied
+func (t *Transport) shouldRetry(method string, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	idempotent := isIdempotent(method)
+
+	if err != nil {
+		return t.backoff(attempt), idempotent
+	}
+
+	if wait, ok := retryAfter(resp); ok {
+		return t.bounded(wait), resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests || (idempotent && resp.StatusCode >= 500)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return t.backoff(attempt), true
+
+	case resp.StatusCode >= 500:
+		return t.backoff(attempt), idempotent
+
+	case resp.StatusCode == http.StatusForbidden && resp.Header.Get(rateLimitRemainingHeaderName) == "0":
+		reset, err := strconv.ParseInt(resp.Header.Get(rateLimitResetHeaderName), 10, 64)
+		if err != nil {
+			return t.backoff(attempt), true
+		}
+
+		return t.bounded(time.Until(time.Unix(reset, 0))), true
+
+	case resp.StatusCode == http.StatusForbidden && isSecondaryRateLimit(resp):
+		return t.backoff(attempt), true
+
+	default:
+		return 0, false
+	}
+}
+
+// backoff returns an exponentially increasing wait with up to 50% jitter
+func (t *Transport) backoff(attempt int) time.Duration {
+	wait := t.MinBackoff << uint(attempt)
+	if wait <= 0 {
+		return 0
+	}
+
+	wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+
+	return t.bounded(wait)
+}
+
+func (t *Transport) bounded(wait time.Duration) time.Duration {
+	switch {
+	case wait < 0:
+		return 0
+	case t.MaxBackoff > 0 && wait > t.MaxBackoff:
+		return t.MaxBackoff
+	default:
+		return wait
+	}
+}
+
+// attemptContext bounds a single attempt at a request by AttemptTimeout
+func (t *Transport) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.AttemptTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, t.AttemptTimeout)
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+
+	return t.Base
+}
+
+// lookup returns a previously cached response for conditional GET requests
+func (t *Transport) lookup(req *http.Request, cacheKey string) *cachedResponse {
+	if req.Method != http.MethodGet || req.Header.Get(ifNoneMatchHeaderName) != "" {
+		return nil
+	}
+
+	t.locker.Lock()
+	defer t.locker.Unlock()
+
+	return t.cached[cacheKey]
+}
+
+// remember caches GET responses carrying an ETag and replays the cached
+// response when GitHub reports it has not been modified
+func (t *Transport) remember(req *http.Request, cacheKey string, cached *cachedResponse, resp *http.Response) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+
+		return &http.Response{
+			Status:        cached.status,
+			StatusCode:    cached.code,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        cached.header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader(cached.body)),
+			ContentLength: int64(len(cached.body)),
+			Request:       req,
+		}, nil
+	}
+
+	etag := resp.Header.Get(etagHeaderName)
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.locker.Lock()
+	defer t.locker.Unlock()
+
+	if t.cached == nil {
+		t.cached = map[string]*cachedResponse{}
+	}
+
+	if _, found := t.cached[cacheKey]; !found {
+		if t.MaxCached > 0 && len(t.cachedOrder) >= t.MaxCached {
+			delete(t.cached, t.cachedOrder[0])
+			t.cachedOrder = t.cachedOrder[1:]
+		}
+
+		t.cachedOrder = append(t.cachedOrder, cacheKey)
+	}
+
+	t.cached[cacheKey] = &cachedResponse{
+		etag:   etag,
+		status: resp.Status,
+		code:   resp.StatusCode,
+		header: resp.Header.Clone(),
+		body:   body,
+	}
+
+	return resp, nil
+}
+
+// isIdempotent returns whether repeating a request with method has the same
+// effect as making it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancelOnClose ends an attempt at a request once its response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// isSecondaryRateLimit checks the body of a 403 response for GitHub's
+// secondary (abuse) rate limit message, leaving the body readable
+func isSecondaryRateLimit(resp *http.Response) bool {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	message := strings.ToLower(string(body))
+	return strings.Contains(message, "secondary rate limit") || strings.Contains(message, "abuse detection")
+}
+
+// retryAfter parses the Retry-After header, which GitHub sends as a number of seconds
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := strings.TrimSpace(resp.Header.Get(retryAfterHeaderName))
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
@@ -0,0 +1,232 @@
+package github_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+var _ = Describe("Transport", func() {
+	var (
+		server    *httptest.Server
+		transport *github.Transport
+		client    *http.Client
+		responses []func(w http.ResponseWriter, r *http.Request)
+		requests  []*http.Request
+		bodies    []string
+	)
+
+	BeforeEach(func() {
+		responses = nil
+		requests = nil
+		bodies = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			requests = append(requests, r)
+			bodies = append(bodies, string(body))
+
+			respond := responses[0]
+			if len(responses) > 1 {
+				responses = responses[1:]
+			}
+
+			respond(w, r)
+		}))
+
+		transport = github.NewTransport(http.DefaultTransport)
+		transport.MinBackoff = time.Millisecond
+		transport.MaxBackoff = 10 * time.Millisecond
+
+		client = &http.Client{Transport: transport}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	respondWith := func(status int, header map[string]string, body string) func(w http.ResponseWriter, r *http.Request) {
+		return func(w http.ResponseWriter, r *http.Request) {
+			for k, v := range header {
+				w.Header().Set(k, v)
+			}
+
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}
+	}
+
+	It("retries requests GitHub failed to serve", func() {
+		responses = append(responses,
+			respondWith(http.StatusBadGateway, nil, ""),
+			respondWith(http.StatusServiceUnavailable, nil, ""),
+			respondWith(http.StatusOK, nil, `{"number": 1}`),
+		)
+
+		resp, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(requests).To(HaveLen(3))
+	})
+
+	It("does not retry requests which GitHub may have applied", func() {
+		responses = append(responses, respondWith(http.StatusBadGateway, nil, ""))
+
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"title": "A KEP"}`))
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusBadGateway))
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("retries rate limited requests, replaying the request body", func() {
+		responses = append(responses,
+			respondWith(http.StatusTooManyRequests, nil, ""),
+			respondWith(http.StatusForbidden, nil, `{"message": "You have exceeded a secondary rate limit"}`),
+			respondWith(http.StatusCreated, nil, `{"number": 1}`),
+		)
+
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"title": "A KEP"}`))
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+		Expect(requests).To(HaveLen(3))
+		Expect(bodies).To(ConsistOf(`{"title": "A KEP"}`, `{"title": "A KEP"}`, `{"title": "A KEP"}`))
+	})
+
+	It("bounds each attempt rather than the waits between attempts", func() {
+		transport.AttemptTimeout = 50 * time.Millisecond
+		transport.MinBackoff = 100 * time.Millisecond
+		transport.MaxBackoff = 100 * time.Millisecond
+
+		responses = append(responses,
+			func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+				w.WriteHeader(http.StatusOK)
+			},
+			respondWith(http.StatusServiceUnavailable, nil, ""),
+			respondWith(http.StatusOK, nil, "{}"),
+		)
+
+		resp, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(requests).To(HaveLen(3))
+	})
+
+	It("waits as instructed by Retry-After when secondary rate limited", func() {
+		responses = append(responses,
+			respondWith(http.StatusForbidden, map[string]string{"Retry-After": "0"}, `{"message": "You have exceeded a secondary rate limit"}`),
+			respondWith(http.StatusOK, nil, "{}"),
+		)
+
+		resp, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(requests).To(HaveLen(2))
+	})
+
+	It("waits for the rate limit to reset when exhausted", func() {
+		reset := time.Now().Unix()
+
+		responses = append(responses,
+			respondWith(http.StatusForbidden, map[string]string{
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(reset, 10),
+			}, `{"message": "API rate limit exceeded"}`),
+			respondWith(http.StatusOK, nil, "{}"),
+		)
+
+		resp, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(requests).To(HaveLen(2))
+	})
+
+	It("does not retry requests GitHub rejected for other reasons", func() {
+		responses = append(responses, respondWith(http.StatusForbidden, nil, `{"message": "Must have admin rights to Repository."}`))
+
+		resp, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+		Expect(requests).To(HaveLen(1))
+
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("admin rights"))
+	})
+
+	It("gives up after the maximum number of retries", func() {
+		responses = append(responses, respondWith(http.StatusInternalServerError, nil, ""))
+
+		resp, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		Expect(requests).To(HaveLen(github.DefaultMaxRetries + 1))
+	})
+
+	It("revalidates cached responses with conditional requests", func() {
+		responses = append(responses,
+			respondWith(http.StatusOK, map[string]string{"ETag": `"abc123"`}, `{"number": 1}`),
+			respondWith(http.StatusNotModified, nil, ""),
+		)
+
+		resp, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+
+		resp, err = client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Header.Get("If-None-Match")).To(Equal(`"abc123"`))
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal(`{"number": 1}`))
+	})
+
+	It("bounds the number of cached responses", func() {
+		transport.MaxCached = 1
+
+		responses = append(responses, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", `"`+r.URL.Path+`"`)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		for _, path := range []string{"/first", "/second", "/first"} {
+			resp, err := client.Get(server.URL + path)
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+		}
+
+		Expect(requests).To(HaveLen(3))
+		Expect(requests[2].Header.Get("If-None-Match")).To(BeEmpty())
+	})
+})
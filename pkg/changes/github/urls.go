@@ -2,24 +2,121 @@ package github
 
 import (
 	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 )
 
-// GitUrl returns the URL to use for Git operations against a repo hosted on GitHub (e.g. clone, pu
+const (
+	// DefaultApiUrl is the location of the public GitHub API
+	DefaultApiUrl = "https://api.github.com"
+
+	// DefaultGitUrl is the location used for Git operations against public GitHub
+	DefaultGitUrl = "https://github.com"
+
+	// ApiUrlEnv overrides the location of the GitHub API (e.g. GitHub Enterprise)
+	ApiUrlEnv = "KEP_GITHUB_API_URL"
+
+	// GitUrlEnv overrides the location used for Git operations
+	GitUrlEnv = "KEP_GITHUB_URL"
+)
+
+// Options describe where GitHub is located. The zero value refers to public GitHub
+type Options struct {
+	ApiUrl string
+	GitUrl string
+}
+
+// DefaultOptions returns Options pointing at public GitHub unless
+// overridden by the environment
+func DefaultOptions() Options {
+	return Options{
+		ApiUrl: os.Getenv(ApiUrlEnv),
+		GitUrl: os.Getenv(GitUrlEnv),
+	}
+}
+
+func (o Options) apiUrl() string {
+	if o.ApiUrl == "" {
+		return DefaultApiUrl
+	}
+
+	return strings.TrimSuffix(o.ApiUrl, "/")
+}
+
+func (o Options) gitUrl() string {
+	if o.GitUrl == "" {
+		return DefaultGitUrl
+	}
+
+	return strings.TrimSuffix(o.GitUrl, "/")
+}
+
+// RepoGitUrl returns the URL to use for Git operations against a repo hosted on GitHub (e.g. clone, push)
+func (o Options) RepoGitUrl(owner string, repo string) string {
+	return fmt.Sprintf("%s/%s/%s.git", o.gitUrl(), owner, repo)
+}
+
+// ForkUrl returns the URL to use when issuing a fork request to the GitHub API
+func (o Options) ForkUrl(owner string, repo string) string {
+	return fmt.Sprintf("%s/repos/%s/%s/forks", o.apiUrl(), owner, repo)
+}
+
+// PrUrl returns the URL to use when creating a pull request against a repo hosted on GitHub
+func (o Options) PrUrl(owner string, repo string) string {
+	return fmt.Sprintf("%s/repos/%s/%s/pulls", o.apiUrl(), owner, repo)
+}
+
+// RepoApiUrl returns the URL to use when performing CRUD operations against a repo hosted on GitHub
+func (o Options) RepoApiUrl(owner string, repo string) string {
+	return fmt.Sprintf("%s/repos/%s/%s", o.apiUrl(), owner, repo)
+}
+
+// InstallationTokenUrl returns the URL to use when creating an installation token for a GitHub App
+func (o Options) InstallationTokenUrl(installationID int64) string {
+	return fmt.Sprintf("%s/app/installations/%d/access_tokens", o.apiUrl(), installationID)
+}
+
+// GitUrl returns the URL to use for Git operations against a repo hosted on GitHub (e.g. clone, push)
 func GitUrl(owner string, repo string) string {
-	return fmt.Sprintf("https://github.com/%s/%s.git", owner, repo)
+	return DefaultOptions().RepoGitUrl(owner, repo)
 }
 
 // ForkUrl returns the URL to use when issuing a fork request to the GitHub API
 func ForkUrl(owner string, repo string) string {
-	return fmt.Sprintf("https://api.github.com/repos/%s/%s/forks", owner, repo)
+	return DefaultOptions().ForkUrl(owner, repo)
 }
 
 // PrUrl returns the URL to use when creating a pull request against a repo hosted on GitHub
 func PrUrl(owner string, repo string) string {
-	return fmt.Sprintf("https://api.github.com/repos/%s/%s/pulls", owner, repo)
+	return DefaultOptions().PrUrl(owner, repo)
 }
 
-// RepoApiUrl returns the URL to use when performing CRUD operations against a repo hosted on GitHu
+// RepoApiUrl returns the URL to use when performing CRUD operations against a repo hosted on GitHub
 func RepoApiUrl(owner string, repo string) string {
-	return fmt.Sprintf("https://api.github.com/repos/%s/%s", owner, repo)
+	return DefaultOptions().RepoApiUrl(owner, repo)
+}
+
+// ParsePullRequestUrl returns the owner, repository and number of the pull request
+// at the human consumable URL u (e.g. https://github.com/octocat/Hello-World/pull/42)
+func ParsePullRequestUrl(u string) (string, string, int, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) < 4 || parts[len(parts)-2] != "pull" {
+		return "", "", 0, fmt.Errorf("not a pull request URL: %s", u)
+	}
+
+	parts = parts[len(parts)-4:]
+
+	number, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("not a pull request URL: %s", u)
+	}
+
+	return parts[0], parts[1], number, nil
 }
@@ -0,0 +1,169 @@
+package changes
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/calebamiles/keps/pkg/changes/git"
+	"github.com/calebamiles/keps/pkg/settings/cache"
+)
+
+const (
+	// ReviewRemoteName is the Git remote local changes are pushed to, see LocalOptions.PushTo
+	ReviewRemoteName = "review"
+)
+
+// LocalOptions describe how changes made without GitHub are delivered for review
+type LocalOptions struct {
+	// Base is the revision the patch series starts after, defaults to HEAD when the changes are opened
+	Base string
+
+	// PatchDir is where the patch series is written, defaults to patches/<branch> in cache.Dir()
+	PatchDir string
+
+	// PushTo is the URL of a remote the branch is pushed to, when set
+	PushTo string
+}
+
+// Local prepares changes on a new branch, started from HEAD, of the existing
+// repository at localPath without using GitHub. Uncommitted new files are
+// carried over to the new branch. Submitting the changes writes the commits
+// made on the branch as a `git format-patch` style series, introduced by a
+// cover letter, and pushes the branch to LocalOptions.PushTo when set
+func Local(author Author, localPath string, withBranchName string, opts LocalOptions) (Reviewable, error) {
+	repo, err := git.Open(localPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLocal(repo, author, localPath, withBranchName, opts)
+}
+
+// LocalCopy prepares changes, like Local, in a clone at toLocation of the existing
+// repository at localPath, leaving the working tree at localPath alone. Only
+// committed files are carried over to the new branch, which is only found in
+// the clone and at LocalOptions.PushTo
+func LocalCopy(author Author, localPath string, toLocation string, withBranchName string, opts LocalOptions) (Reviewable, error) {
+	repo, err := git.CloneLocal(localPath, toLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLocal(repo, author, toLocation, withBranchName, opts)
+}
+
+// newLocal starts withBranchName from HEAD of repo
+func newLocal(repo git.Repo, author Author, localPath string, withBranchName string, opts LocalOptions) (Reviewable, error) {
+	var err error
+	if opts.Base == "" {
+		opts.Base, err = repo.Head()
+		if err != nil {
+			log.Errorf("resolving HEAD of %s: %s", localPath, err)
+			return nil, err
+		}
+	}
+
+	if opts.PatchDir == "" {
+		opts.PatchDir = filepath.Join(cache.Dir(), "patches", withBranchName)
+	}
+
+	err = repo.Checkout(withBranchName)
+	if err != nil {
+		log.Errorf("checking out branch %s: %s", withBranchName, err)
+		return nil, err
+	}
+
+	l := &local{
+		repo:       repo,
+		author:     author,
+		localPath:  localPath,
+		branchName: withBranchName,
+		opts:       opts,
+		locker:     &sync.Mutex{},
+	}
+
+	return l, nil
+}
+
+type local struct {
+	repo       git.Repo
+	author     Author
+	localPath  string
+	branchName string
+	opts       LocalOptions
+
+	locker sync.Locker
+}
+
+func (l *local) LocalPath() string  { return l.localPath }
+func (l *local) BranchName() string { return l.branchName }
+
+// Add copies the file or directory tree at fromLocation to toLocationAfterRoot, relative
+// to the root of the repository, and stages the copied files
+func (l *local) Add(fromLocation string, toLocationAfterRoot string) error {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+
+	return addTree(l.repo, l.localPath, fromLocation, toLocationAfterRoot)
+}
+
+// Commit commits staged changes as the author
+func (l *local) Commit(message string) error {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+
+	return l.repo.CommitWithOptions(message, l.author.commitOptions())
+}
+
+// Submit writes the patch series, replacing any patches previously written to
+// the patch directory, and returns the location of the patch directory
+func (l *local) Submit(title string, description string) (string, error) {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+
+	stale, err := filepath.Glob(filepath.Join(l.opts.PatchDir, "*.patch"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, p := range stale {
+		err = os.Remove(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	_, err = l.repo.FormatPatch(git.PatchOptions{
+		Since:        l.opts.Base,
+		ToDir:        l.opts.PatchDir,
+		CoverSubject: title,
+		CoverBody:    description,
+		Name:         l.author.Name,
+		Email:        l.author.Email,
+	})
+
+	if err != nil {
+		log.Errorf("writing patch series: %s", err)
+		return "", err
+	}
+
+	if l.opts.PushTo == "" {
+		return l.opts.PatchDir, nil
+	}
+
+	err = l.repo.SetRemote(ReviewRemoteName, l.opts.PushTo)
+	if err != nil {
+		return "", err
+	}
+
+	err = l.repo.Push(nil, ReviewRemoteName, l.branchName, l.branchName)
+	if err != nil {
+		log.Errorf("pushing branch %s to %s: %s", l.branchName, l.opts.PushTo, err)
+		return "", err
+	}
+
+	return l.opts.PatchDir, nil
+}
@@ -0,0 +1,112 @@
+package changes_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	git "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+type localGitHub struct {
+	server   *httptest.Server
+	options  github.Options
+	requests []*http.Request
+}
+
+// newLocalGitHub creates bare repositories for owner/repo and forkOwner/repo under root,
+// with owner/repo containing a single commit on master, and a server answering fork, pull
+// request and delete requests. The returned options point git operations at root and API
+// requests at the server
+func newLocalGitHub(root string, owner string, forkOwner string, repo string) (*localGitHub, error) {
+	upstreamLocation := filepath.Join(root, owner, repo+".git")
+	forkLocation := filepath.Join(root, forkOwner, repo+".git")
+
+	_, err := git.PlainInit(upstreamLocation, true)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = git.PlainInit(forkLocation, true)
+	if err != nil {
+		return nil, err
+	}
+
+	workLocation, err := ioutil.TempDir("", "local-github-work")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(workLocation)
+
+	workRepo, err := git.PlainInit(workLocation, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(workLocation, "README.md"), []byte("# Hello World\n"), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	worktree, err := workRepo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = worktree.Add("README.md")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = worktree.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = workRepo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{upstreamLocation}})
+	if err != nil {
+		return nil, err
+	}
+
+	err = workRepo.Push(&git.PushOptions{RemoteName: "origin"})
+	if err != nil {
+		return nil, err
+	}
+
+	l := &localGitHub{}
+	l.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l.requests = append(l.requests, r)
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/forks"):
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"url": "https://api.github.com/repos/fork", "html_url": "https://github.com/fork"}`))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/pulls"):
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"number": 1, "html_url": "https://github.com/pull/1"}`))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	l.options = github.Options{
+		ApiUrl: l.server.URL,
+		GitUrl: root,
+	}
+
+	return l, nil
+}
@@ -0,0 +1,103 @@
+package changes_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	git "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes"
+)
+
+var _ = Describe("Proposing changes without GitHub", func() {
+	var (
+		tmpDir   string
+		repoPath string
+		patchDir string
+		author   changes.Author
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "keps-changes-local")
+		Expect(err).ToNot(HaveOccurred())
+
+		repoPath = filepath.Join(tmpDir, "repo")
+		patchDir = filepath.Join(tmpDir, "patches")
+		author = changes.Author{Name: "Jane Doe", Email: "jane@example.com"}
+
+		repo, err := git.PlainInit(repoPath, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ioutil.WriteFile(filepath.Join(repoPath, "README.md"), []byte("# Hello World\n"), os.ModePerm)).To(Succeed())
+
+		worktree, err := repo.Worktree()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = worktree.Add("README.md")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = worktree.Commit("initial commit", &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("commits to a local branch and submits a patch series", func() {
+		Expect(os.MkdirAll(patchDir, os.ModePerm)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(patchDir, "0007-stale.patch"), []byte("stale"), os.ModePerm)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(patchDir, "notes.txt"), []byte("keep me"), os.ModePerm)).To(Succeed())
+
+		local, err := changes.Local(author, repoPath, "a-great-kep", changes.LocalOptions{PatchDir: patchDir})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(local.BranchName()).To(Equal("a-great-kep"))
+
+		exampleLocation := filepath.Join(tmpDir, "example.md")
+		Expect(ioutil.WriteFile(exampleLocation, []byte("example content"), os.ModePerm)).To(Succeed())
+
+		Expect(local.Add(exampleLocation, "example.md")).To(Succeed())
+		Expect(local.Commit("Add an example")).To(Succeed())
+
+		location, err := local.Submit("Add an example", "Please pull this in")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(location).To(Equal(patchDir))
+
+		patches, err := filepath.Glob(filepath.Join(patchDir, "*.patch"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patches).To(ConsistOf(
+			filepath.Join(patchDir, "0000-cover-letter.patch"),
+			filepath.Join(patchDir, "0001-Add-an-example.patch"),
+		))
+
+		Expect(filepath.Join(patchDir, "notes.txt")).To(BeAnExistingFile())
+
+		gitRepo, err := git.PlainOpen(repoPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		head, err := gitRepo.Head()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(head.Name().Short()).To(Equal("a-great-kep"))
+	})
+
+	It("starts the patch series after the configured base", func() {
+		local, err := changes.Local(author, repoPath, "a-great-kep", changes.LocalOptions{PatchDir: patchDir, Base: "HEAD"})
+		Expect(err).ToNot(HaveOccurred())
+
+		location, err := local.Submit("Nothing yet", "")
+		Expect(err).ToNot(HaveOccurred())
+
+		patches, err := filepath.Glob(filepath.Join(location, "*.patch"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patches).To(ConsistOf(filepath.Join(patchDir, "0000-cover-letter.patch")))
+	})
+})
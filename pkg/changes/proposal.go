@@ -0,0 +1,266 @@
+package changes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/calebamiles/keps/pkg/changes/git"
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+// Changes are made on a branch of a local Git repository
+type Changes interface {
+	Add(fromLocation string, toLocationAfterRoot string) error
+	Commit(message string) error
+	LocalPath() string
+	BranchName() string
+}
+
+// A Review delivers committed changes for review, returning a human consumable
+// location of the review such as a pull request URL or a patch series directory
+type Review interface {
+	Submit(title string, description string) (string, error)
+}
+
+// Reviewable changes can be submitted for review
+type Reviewable interface {
+	Changes
+	Review
+}
+
+// A Proposal is a set of changes made on a branch of a local Git repository which
+// are delivered as a GitHub Pull Request from the principal's fork to upstream.
+// Submitting a Proposal opens the pull request
+type Proposal interface {
+	Reviewable
+	OpenPR(title github.PullRequestTitle, description github.PullRequestDescription) (*github.PullRequest, error) // push local changes, hit PR API
+	DeleteLocal() error
+	DeleteFork() error
+}
+
+// Author identifies who changes are committed on behalf of and how commits are attributed
+type Author struct {
+	Name  string
+	Email string
+
+	SignOff   bool       // add a DCO `Signed-off-by` trailer
+	CoAuthors []string   // `Name <email>` of each co-author
+	Signer    git.Signer // sign commits when set
+}
+
+func (a Author) commitOptions() git.CommitOptions {
+	return git.CommitOptions{
+		Name:      a.Name,
+		Email:     a.Email,
+		SignOff:   a.SignOff,
+		CoAuthors: a.CoAuthors,
+		Signer:    a.Signer,
+	}
+}
+
+// Upstream identifies the repository changes are proposed to. An empty Branch
+// refers to the branch HEAD refers to upstream
+type Upstream struct {
+	Owner      string
+	Repository string
+	Branch     string
+}
+
+type proposal struct {
+	repo       git.Repo
+	client     *github.Client
+	principal  string
+	author     Author
+	upstream   Upstream
+	localPath  string
+	branchName string
+
+	locker       sync.Locker
+	localDeleted bool
+	forkDeleted  bool
+}
+
+func (p *proposal) LocalPath() string  { return p.localPath }
+func (p *proposal) BranchName() string { return p.branchName }
+
+// Add copies the file or directory tree at fromLocation to toLocationAfterRoot, relative
+// to the root of the repository, and stages the copied files. Files already in place are
+// only staged
+func (p *proposal) Add(fromLocation string, toLocationAfterRoot string) error {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	return addTree(p.repo, p.localPath, fromLocation, toLocationAfterRoot)
+}
+
+// Commit commits staged changes as the author of the proposal
+func (p *proposal) Commit(message string) error {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	return p.repo.CommitWithOptions(message, p.author.commitOptions())
+}
+
+// Submit opens a pull request, see OpenPR
+func (p *proposal) Submit(title string, description string) (string, error) {
+	pr, err := p.OpenPR(github.PullRequestTitle(title), github.PullRequestDescription(description))
+	if err != nil {
+		return "", err
+	}
+
+	return pr.HtmlUrl, nil
+}
+
+// OpenPR pushes the proposal branch to the principal's fork and opens a pull
+// request against upstream, returning an already open pull request for the
+// branch if one exists
+func (p *proposal) OpenPR(title github.PullRequestTitle, description github.PullRequestDescription) (*github.PullRequest, error) {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	if p.localDeleted || p.forkDeleted {
+		return nil, fmt.Errorf("cannot open pull request if either local or forked repository has been deleted. Local deleted: %t. Fork deleted: %t", p.localDeleted, p.forkDeleted)
+	}
+
+	err := p.repo.PushOrigin(p.client.Token, p.branchName, p.branchName)
+	if err != nil {
+		log.Errorf("pushing local changes: %s", err)
+		return nil, err
+	}
+
+	routingInfo := github.NewPullRequestRoutingInfo(
+		p.client.Token,
+		github.SourceOwner(p.principal),
+		github.SourceRepository(p.upstream.Repository),
+		github.SourceBranch(p.branchName),
+		github.TargetOwner(p.upstream.Owner),
+		github.TargetRepository(p.upstream.Repository),
+		github.TargetBranch(p.upstream.Branch),
+	)
+
+	pr, err := p.client.CreatePR(context.Background(), routingInfo, title, description)
+	if err != nil {
+		log.Errorf("creating GitHub Pull Request: %s", err)
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// DeleteLocal removes the local repository
+func (p *proposal) DeleteLocal() error {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	if p.localDeleted {
+		log.Info("local Git repository should already be deleted, skipping request")
+		return nil
+	}
+
+	err := os.RemoveAll(p.localPath)
+	if err != nil {
+		log.Errorf("deleting local Git repository: %s", err)
+		return err
+	}
+
+	p.localDeleted = true
+
+	return nil
+}
+
+// DeleteFork removes the principal's fork on GitHub
+func (p *proposal) DeleteFork() error {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+
+	if p.forkDeleted {
+		log.Info("GitHub repository should already be deleted, skipping request")
+		return nil
+	}
+
+	err := p.client.DeleteRepo(context.Background(), p.principal, p.upstream.Repository)
+	if err != nil {
+		log.Errorf("deleting GitHub fork: %s", err)
+		return err
+	}
+
+	p.forkDeleted = true
+
+	return nil
+}
+
+// addTree copies the file or directory tree at fromLocation to toLocationAfterRoot,
+// relative to localPath, and stages the copied files
+func addTree(repo git.Repo, localPath string, fromLocation string, toLocationAfterRoot string) error {
+	return filepath.Walk(fromLocation, func(src string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(fromLocation, src)
+		if err != nil {
+			return err
+		}
+
+		dst := filepath.Join(toLocationAfterRoot, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(localPath, dst), os.ModePerm)
+		}
+
+		err = copyFile(src, filepath.Join(localPath, dst), info.Mode())
+		if err != nil {
+			log.Errorf("copying %s into repository: %s", src, err)
+			return err
+		}
+
+		return repo.Add(filepath.ToSlash(dst))
+	})
+}
+
+func copyFile(fromLocation string, toLocation string, mode os.FileMode) error {
+	fromAbs, err := filepath.Abs(fromLocation)
+	if err != nil {
+		return err
+	}
+
+	toAbs, err := filepath.Abs(toLocation)
+	if err != nil {
+		return err
+	}
+
+	if fromAbs == toAbs {
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(toLocation), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	from, err := os.Open(fromLocation)
+	if err != nil {
+		return err
+	}
+
+	defer from.Close()
+
+	to, err := os.OpenFile(toLocation, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(to, from)
+	if err != nil {
+		to.Close()
+		return err
+	}
+
+	return to.Close()
+}
@@ -1,8 +0,0 @@
-package hermetic
-
-import (
-	"net/http"
-)
-
-// packageHttpClient is the HTTP client to use when issuing GitHub requests
-var packageHttpClient *http.Client = &http.Client{}
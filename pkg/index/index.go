@@ -16,6 +16,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
 	"github.com/calebamiles/keps/pkg/keps/states"
 	"github.com/calebamiles/keps/pkg/settings"
 )
@@ -26,6 +27,14 @@ type Index interface {
 
 	Fetch(string) (keps.Instance, error)
 
+	// queries by person compare GitHub handles, see metadata.ParseIdentity
+	FindByAuthor(identity string) []keps.Instance
+	FindByReviewer(identity string) []keps.Instance
+	FindByApprover(identity string) []keps.Instance
+
+	// FindByTheme returns the KEPs in a development theme, see themes.All
+	FindByTheme(theme string) []keps.Instance
+
 	// TODO add Filter(metadata.KEP) metadata.KEP
 	// TODO add Remove(keps.Instance)
 	Update(keps.Instance) error
@@ -69,7 +78,7 @@ func Open(contentRoot string) (Index, error) {
 
 	var allErrs *multierror.Error
 	for _, entry := range idx.KEPs {
-		k, err := keps.Open(entry.ContentLocationField)
+		k, err := keps.Open(contentRoot, entry.ContentLocationField)
 		if err != nil {
 			// TODO add log
 			allErrs = multierror.Append(allErrs, err)
@@ -91,6 +100,81 @@ func Open(contentRoot string) (Index, error) {
 	return idx, nil
 }
 
+// Relocate replaces the entries persisted in the index at contentRoot for the
+// KEP, found by unique ID, by its former location from or by its new location,
+// with a single entry for k, without opening any other KEP. It is a no-op when no index has been
+// persisted at contentRoot
+func Relocate(contentRoot string, from string, k keps.Instance) error {
+	indexLocation := filepath.Join(contentRoot, indexFilename)
+
+	indexBytes, err := ioutil.ReadFile(indexLocation)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	idx := &index{}
+	err = yaml.Unmarshal(indexBytes, idx)
+	if err != nil {
+		return err
+	}
+
+	// every entry for the KEP, by unique ID, former or new location, collapses into one
+	entries := []*kepEntry{}
+	relocated := false
+	for _, entry := range idx.KEPs {
+		location := filepath.Clean(entry.ContentLocationField)
+		if entry.UUIDField != k.UniqueID() && location != filepath.Clean(from) && location != filepath.Clean(k.ContentDir()) {
+			entries = append(entries, entry)
+			continue
+		}
+
+		if !relocated {
+			entries = append(entries, newKEPEntry(k))
+			relocated = true
+		}
+	}
+
+	idx.KEPs = entries
+
+	entriesBytes, err := yaml.Marshal(idx)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(indexLocation, entriesBytes, os.ModePerm)
+}
+
+// Locations returns the content location of each KEP persisted in the index at
+// contentRoot without opening any KEP. It returns nil when no index has been
+// persisted at contentRoot
+func Locations(contentRoot string) ([]string, error) {
+	indexBytes, err := ioutil.ReadFile(filepath.Join(contentRoot, indexFilename))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &index{}
+	err = yaml.Unmarshal(indexBytes, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := []string{}
+	for _, entry := range idx.KEPs {
+		locations = append(locations, entry.ContentLocationField)
+	}
+
+	return locations, nil
+}
+
 // Rebuild
 // - walks directories starting at runtime.ContentRoot() looking for KEP metadata.yaml
 //   files in the tree. For each metadata.yaml that is found
@@ -116,7 +200,7 @@ func Rebuild(runtime settings.Runtime) (Index, error) {
 
 		containingDir := filepath.Dir(path)
 
-		kep, err := keps.Open(containingDir)
+		kep, err := keps.Open(runtime.ContentRoot(), containingDir)
 		if err != nil {
 			log.Errorf("error opening KEP at path: %s, with error: %s", containingDir, err)
 			allErrors = multierror.Append(allErrors, err)
@@ -184,6 +268,57 @@ func (i *index) Fetch(id string) (keps.Instance, error) {
 	return k, nil
 }
 
+func (i *index) FindByAuthor(identity string) []keps.Instance {
+	return i.findByPerson(identity, keps.Instance.Authors)
+}
+
+func (i *index) FindByReviewer(identity string) []keps.Instance {
+	return i.findByPerson(identity, keps.Instance.Reviewers)
+}
+
+func (i *index) FindByApprover(identity string) []keps.Instance {
+	return i.findByPerson(identity, keps.Instance.Approvers)
+}
+
+func (i *index) FindByTheme(theme string) []keps.Instance {
+	i.locker.RLock()
+	defer i.locker.RUnlock()
+
+	found := []keps.Instance{}
+	for _, k := range i.kepsSet {
+		for _, t := range k.DevelopmentThemes() {
+			if t == theme {
+				found = append(found, k)
+				break
+			}
+		}
+	}
+
+	sort.Slice(found, func(a, b int) bool { return found[a].ShortID() < found[b].ShortID() })
+
+	return found
+}
+
+// findByPerson returns the KEPs, ordered by short ID, where people(k) includes identity
+func (i *index) findByPerson(identity string, people func(keps.Instance) []string) []keps.Instance {
+	i.locker.RLock()
+	defer i.locker.RUnlock()
+
+	found := []keps.Instance{}
+	for _, k := range i.kepsSet {
+		for _, person := range people(k) {
+			if metadata.SameIdentity(person, identity) {
+				found = append(found, k)
+				break
+			}
+		}
+	}
+
+	sort.Slice(found, func(a, b int) bool { return found[a].ShortID() < found[b].ShortID() })
+
+	return found
+}
+
 func (i *index) HasShortID(given int) bool {
 	// no additional locking should be needed here
 	_, found := i.kepShortIDs.Load(given)
@@ -202,16 +337,7 @@ func (i *index) Persist() error {
 	// we build this here in order to make removing KEPs easy
 	entryList := []*kepEntry{}
 	for _, k := range i.kepsSet {
-		entryList = append(entryList, &kepEntry{
-			ShortIDField:         k.ShortID(),
-			UUIDField:            k.UniqueID(),
-			TitleField:           k.Title(),
-			AuthorsField:         k.Authors(),
-			ContentLocationField: k.ContentDir(),
-			CreatedField:         k.Created(),
-			LastUpdatedField:     k.LastUpdated(),
-			StateField:           k.State(),
-		})
+		entryList = append(entryList, newKEPEntry(k))
 	}
 
 	// TODO add test for this behavior
@@ -243,6 +369,20 @@ type kepEntry struct {
 	StateField           states.Name `yaml:"state"`
 }
 
+func newKEPEntry(k keps.Instance) *kepEntry {
+	return &kepEntry{
+		ShortIDField:         k.ShortID(),
+		UUIDField:            k.UniqueID(),
+		TitleField:           k.Title(),
+		OwningSIGField:       k.OwningSIG(),
+		AuthorsField:         k.Authors(),
+		ContentLocationField: k.ContentDir(),
+		CreatedField:         k.Created(),
+		LastUpdatedField:     k.LastUpdated(),
+		StateField:           k.State(),
+	}
+}
+
 type ByIncreasingAge []*kepEntry
 
 func (a ByIncreasingAge) Len() int           { return len(a) }
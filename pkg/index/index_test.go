@@ -11,6 +11,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/calebamiles/keps/pkg/index"
+	"github.com/calebamiles/keps/pkg/keps"
 	"github.com/calebamiles/keps/pkg/keps/states"
 
 	"github.com/calebamiles/keps/pkg/keps/kepsfakes"
@@ -27,9 +28,9 @@ var _ = Describe("working with an index of KEPs", func() {
 			Expect(err).ToNot(HaveOccurred())
 			defer os.RemoveAll(tmpDir)
 
-			kepDirOne := filepath.Join(tmpDir, "sig-architecture", "kep1")
-			kepDirTwo := filepath.Join(tmpDir, "sig-architecture", "kep2")
-			kepDirThree := filepath.Join(tmpDir, "sig-architecture", "kep3")
+			kepDirOne := filepath.Join(tmpDir, "sig-architecture", "sig-wide", "kep1")
+			kepDirTwo := filepath.Join(tmpDir, "sig-architecture", "sig-wide", "kep2")
+			kepDirThree := filepath.Join(tmpDir, "sig-architecture", "sig-wide", "kep3")
 
 			err = os.MkdirAll(kepDirOne, os.ModePerm)
 			Expect(err).ToNot(HaveOccurred())
@@ -57,9 +58,9 @@ var _ = Describe("working with an index of KEPs", func() {
 				Expect(err).ToNot(HaveOccurred())
 				defer os.RemoveAll(tmpDir)
 
-				kepDirOne := filepath.Join(tmpDir, "sig-architecture", "kep1")
-				kepDirTwo := filepath.Join(tmpDir, "sig-architecture", "kep2")
-				kepDirThree := filepath.Join(tmpDir, "sig-architecture", "kep3")
+				kepDirOne := filepath.Join(tmpDir, "sig-architecture", "sig-wide", "kep1")
+				kepDirTwo := filepath.Join(tmpDir, "sig-architecture", "sig-wide", "kep2")
+				kepDirThree := filepath.Join(tmpDir, "sig-architecture", "sig-wide", "kep3")
 
 				err = os.MkdirAll(kepDirOne, os.ModePerm)
 				Expect(err).ToNot(HaveOccurred())
@@ -198,6 +199,64 @@ var _ = Describe("working with an index of KEPs", func() {
 			})
 		})
 
+		Describe("#FindByAuthor()", func() {
+			It("returns the KEPs listing a person regardless of the form of their handle", func() {
+				tmpDir, err := ioutil.TempDir("", "kep-index")
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(tmpDir)
+
+				first := &kepsfakes.FakeInstance{}
+				first.ShortIDReturns(2)
+				first.UniqueIDReturns("a-valid-uuid")
+				first.AuthorsReturns([]string{"Joe Beda (@jbeda)"})
+				first.ApproversReturns([]string{"@CalebAMiles"})
+
+				second := &kepsfakes.FakeInstance{}
+				second.ShortIDReturns(1)
+				second.UniqueIDReturns("another-valid-uuid")
+				second.AuthorsReturns([]string{"calebamiles", "@JBeda"})
+
+				kepIndex, err := index.New(tmpDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(kepIndex.Update(first)).To(Succeed())
+				Expect(kepIndex.Update(second)).To(Succeed())
+
+				Expect(kepIndex.FindByAuthor("jbeda")).To(Equal([]keps.Instance{second, first}))
+				Expect(kepIndex.FindByAuthor("@calebamiles")).To(Equal([]keps.Instance{second}))
+				Expect(kepIndex.FindByApprover("calebamiles")).To(Equal([]keps.Instance{first}))
+				Expect(kepIndex.FindByReviewer("calebamiles")).To(BeEmpty())
+			})
+		})
+
+		Describe("#FindByTheme()", func() {
+			It("returns the KEPs in a development theme", func() {
+				tmpDir, err := ioutil.TempDir("", "kep-index")
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(tmpDir)
+
+				first := &kepsfakes.FakeInstance{}
+				first.ShortIDReturns(2)
+				first.UniqueIDReturns("a-valid-uuid")
+				first.DevelopmentThemesReturns([]string{"stability", "extensibility"})
+
+				second := &kepsfakes.FakeInstance{}
+				second.ShortIDReturns(1)
+				second.UniqueIDReturns("another-valid-uuid")
+				second.DevelopmentThemesReturns([]string{"stability"})
+
+				kepIndex, err := index.New(tmpDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(kepIndex.Update(first)).To(Succeed())
+				Expect(kepIndex.Update(second)).To(Succeed())
+
+				Expect(kepIndex.FindByTheme("stability")).To(Equal([]keps.Instance{second, first}))
+				Expect(kepIndex.FindByTheme("extensibility")).To(Equal([]keps.Instance{first}))
+				Expect(kepIndex.FindByTheme("security")).To(BeEmpty())
+			})
+		})
+
 		Describe("Open()", func() {
 			It("reads a kep.yaml from disk", func() {
 				tmpDir, err := ioutil.TempDir("", "kep-index")
@@ -226,6 +285,7 @@ func writeTestMetadata(dir string) {
 		CreatedField:     time.Now().Add(-time.Hour),
 		UniqueIDField:    uuid.New().String(),
 		OwningSIGField:   "sig-architecture",
+		SIGWideField:     true,
 	}
 
 	tmBytes, err := yaml.Marshal(tm)
@@ -238,11 +298,12 @@ func writeTestMetadata(dir string) {
 type testMetadata struct {
 	AuthorsField     []string    `yaml:"authors"`
 	TitleField       string      `yaml:"title"`
-	ShortIDField     *int        `yaml:"kep_number",omitempty`
+	ShortIDField     *int        `yaml:"kep_number,omitempty"`
 	StateField       states.Name `yaml:"state"`
 	LastUpdatedField time.Time   `yaml:"last_updated"`
 	CreatedField     time.Time   `yaml:"created"`
 	UniqueIDField    string      `yaml:"uuid"`
 	SectionsField    []string    `yaml:"sections"`
 	OwningSIGField   string      `yaml:"owning_sig"`
+	SIGWideField     bool        `yaml:"sig_wide"`
 }
@@ -16,7 +16,15 @@ import (
 	"github.com/calebamiles/keps/pkg/sigs"
 )
 
-func ThatAllBasicInvariantsAreSatisfied(meta metadata.KEP) error {
+// ThatAllBasicInvariantsAreSatisfied returns a check of the basic invariants of
+// a KEP stored under contentRoot
+func ThatAllBasicInvariantsAreSatisfied(contentRoot string) That {
+	return func(meta metadata.KEP) error {
+		return thatAllBasicInvariantsAreSatisfied(contentRoot, meta)
+	}
+}
+
+func thatAllBasicInvariantsAreSatisfied(contentRoot string, meta metadata.KEP) error {
 	var errs *multierror.Error
 	var err error
 
@@ -47,6 +55,9 @@ func ThatAllBasicInvariantsAreSatisfied(meta metadata.KEP) error {
 	err = ThatLastUpdatedAfterCreated(meta)
 	errs = multierror.Append(errs, err)
 
+	err = ThatLocationMatchesRouting(contentRoot)(meta)
+	errs = multierror.Append(errs, err)
+
 	return errs.ErrorOrNil()
 }
 
@@ -74,8 +85,8 @@ func ThatAllSIGsExist(meta metadata.KEP) error {
 	allSIGs := []string{meta.OwningSIG()}
 	allSIGs = append(allSIGs, meta.ParticipatingSIGs()...)
 	for _, sig := range allSIGs {
-		if !sigs.Exists(sig) {
-			errs = multierror.Append(errs, fmt.Errorf("invalid SIG: %s. No SIG information compiled into binary. Try updating", sig))
+		if !sigs.GroupExists(sig) {
+			errs = multierror.Append(errs, fmt.Errorf("invalid SIG: %s. Not found in SIG information from: %s. Try updating", sig, sigs.Source()))
 		}
 	}
 
@@ -114,7 +125,7 @@ func ThatSubprojectsExist(meta metadata.KEP) error {
 
 	for _, subproject := range meta.AffectedSubprojects() {
 		if !sigs.SubprojectExists(subproject) {
-			errs = multierror.Append(errs, fmt.Errorf("invalid subproject: %s. No SIG information compiled into binary. Try updating.", subproject))
+			errs = multierror.Append(errs, fmt.Errorf("invalid subproject: %s. Not found in SIG information from: %s. Try updating", subproject, sigs.Source()))
 		}
 	}
 
@@ -208,3 +219,63 @@ func ThatLastUpdatedAfterCreated(meta metadata.KEP) error {
 
 	return errs.ErrorOrNil()
 }
+
+// ThatLocationMatchesRouting returns a check which recomputes the routing info
+// implied by the directory, under contentRoot, a KEP is stored in and ensures
+// it agrees with the KEP metadata. KEPs which have not been given a directory,
+// or whose content root is not known, are not checked
+func ThatLocationMatchesRouting(contentRoot string) That {
+	return func(meta metadata.KEP) error {
+		return thatLocationMatchesRouting(contentRoot, meta)
+	}
+}
+
+func thatLocationMatchesRouting(contentRoot string, meta metadata.KEP) error {
+	var errs *multierror.Error
+
+	if contentRoot == "" || meta.ContentDir() == "" {
+		return nil
+	}
+
+	targetPath, err := sigs.TargetPath(contentRoot, meta.ContentDir())
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("invalid location: %s. %s", meta.ContentDir(), err))
+		return errs
+	}
+
+	routing, err := sigs.BuildRoutingFromPath(contentRoot, targetPath)
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("invalid location: %s. %s", meta.ContentDir(), err))
+		return errs
+	}
+
+	if meta.OwningSIG() != routing.OwningSIG() {
+		errs = multierror.Append(errs, fmt.Errorf("owning SIG: %s does not match location: %s, expected: %s", meta.OwningSIG(), meta.ContentDir(), routing.OwningSIG()))
+	}
+
+	if meta.KubernetesWide() != routing.KubernetesWide() {
+		errs = multierror.Append(errs, fmt.Errorf("kubernetes wide: %t does not match location: %s", meta.KubernetesWide(), meta.ContentDir()))
+	}
+
+	if meta.SIGWide() != routing.SIGWide() {
+		errs = multierror.Append(errs, fmt.Errorf("SIG wide: %t does not match location: %s", meta.SIGWide(), meta.ContentDir()))
+	}
+
+	for _, subproject := range routing.AffectedSubprojects() {
+		if !contains(meta.AffectedSubprojects(), subproject) {
+			errs = multierror.Append(errs, fmt.Errorf("subproject: %s of location: %s is not listed as an affected subproject", subproject, meta.ContentDir()))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+
+	return false
+}
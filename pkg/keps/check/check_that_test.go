@@ -14,6 +14,8 @@ import (
 
 	"github.com/calebamiles/keps/pkg/keps/check"
 	"github.com/calebamiles/keps/pkg/keps/metadata/metadatafakes"
+	"github.com/calebamiles/keps/pkg/keps/states"
+	"github.com/calebamiles/keps/pkg/keps/themes"
 )
 
 var _ = Describe("Checking Metadata", func() {
@@ -104,6 +106,135 @@ var _ = Describe("Checking Metadata", func() {
 			Expect(merr.Errors).To(HaveLen(2))
 			Expect(merr.Errors[0].Error()).To(ContainSubstring("invalid SIG: sig-not-real-at-all"))
 			Expect(merr.Errors[1].Error()).To(ContainSubstring("invalid SIG: sig-not-real-sorry"))
+
+			By("accepting working groups, user groups and committees")
+			meta.OwningSIGReturns("wg-policy")
+			meta.ParticipatingSIGsReturns([]string{"committee-steering", "ug-big-data", "sig-node"})
+
+			err = check.ThatAllSIGsExist(meta)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Checking that the location matches the routing info", func() {
+		It("ensures the metadata agrees with the directory the KEP is stored in", func() {
+			meta := &metadatafakes.FakeKEP{}
+
+			By("skipping KEPs which have not been given a directory")
+			err := check.ThatLocationMatchesRouting("content")(meta)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("returning no errors if the routing info matches")
+			meta.ContentDirReturns("content/sig-node/kubelet/dynamic-kubelet-configuration")
+			meta.OwningSIGReturns("sig-node")
+			meta.AffectedSubprojectsReturns([]string{"kubelet", "cri-tools"})
+
+			err = check.ThatLocationMatchesRouting("content")(meta)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("skipping KEPs whose content root is not known")
+			meta.ContentDirReturns("content/sig-apps/workloads-api/dynamic-kubelet-configuration")
+
+			err = check.ThatLocationMatchesRouting("")(meta)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("returning an error for each part of the routing info which does not match")
+			meta.ContentDirReturns("content/sig-apps/workloads-api/dynamic-kubelet-configuration")
+
+			err = check.ThatLocationMatchesRouting("content")(meta)
+			merr, ok := err.(*multierror.Error)
+			Expect(ok).To(BeTrue())
+			Expect(merr.Errors).To(HaveLen(2))
+			Expect(merr.Errors[0].Error()).To(ContainSubstring("owning SIG: sig-node does not match location"))
+			Expect(merr.Errors[1].Error()).To(ContainSubstring("subproject: workloads-api"))
+
+			meta.ContentDirReturns("content/sig-node/sig-wide/dynamic-kubelet-configuration")
+
+			err = check.ThatLocationMatchesRouting("content")(meta)
+			merr, ok = err.(*multierror.Error)
+			Expect(ok).To(BeTrue())
+			Expect(merr.Errors).To(HaveLen(1))
+			Expect(merr.Errors[0].Error()).To(ContainSubstring("SIG wide: false does not match location"))
+
+			By("returning an error if the location is not one a KEP can be created at")
+			meta.ContentDirReturns("content/sig-not-real/sig-wide/dynamic-kubelet-configuration")
+
+			err = check.ThatLocationMatchesRouting("content")(meta)
+			Expect(err).To(MatchError(ContainSubstring("invalid location")))
+
+			By("returning an error if the KEP is not stored in the content root")
+			meta.ContentDirReturns("elsewhere/sig-node/kubelet/dynamic-kubelet-configuration")
+
+			err = check.ThatLocationMatchesRouting("content")(meta)
+			Expect(err).To(MatchError(ContainSubstring("invalid location")))
+		})
+	})
+
+	Describe("Checking with a severity", func() {
+		It("reports each problem found with the given severity", func() {
+			meta := &metadatafakes.FakeKEP{}
+			meta.AuthorsReturns([]string{"Joe Beda (@jbeda)"})
+			meta.ApproversReturns([]string{"JBeda"})
+
+			err := check.ThatAuthorIsNotApprover(meta)
+			Expect(check.Findings(err)).To(Equal([]check.Finding{{Severity: check.Error, Message: "JBeda is listed as both an author and approver"}}))
+
+			err = check.WithSeverity(check.Warning, check.ThatAuthorIsNotApprover)(meta)
+			Expect(err).To(HaveOccurred())
+			Expect(check.Findings(err)).To(Equal([]check.Finding{{Severity: check.Warning, Message: "JBeda is listed as both an author and approver"}}))
+
+			By("keeping only problems of error severity as errors")
+			Expect(check.Errors(err)).ToNot(HaveOccurred())
+			Expect(check.BelowError(err)).To(HaveLen(1))
+
+			combined := check.All([]check.That{
+				check.ThatTitleIsSet,
+				check.WithSeverity(check.Warning, check.ThatAuthorIsNotApprover),
+			})(meta)
+
+			Expect(check.Findings(combined)).To(HaveLen(2))
+			Expect(check.Errors(combined)).To(MatchError(ContainSubstring("no title set")))
+			Expect(check.Errors(combined)).ToNot(MatchError(ContainSubstring("approver")))
+		})
+
+		It("orders severities and parses their names", func() {
+			Expect(check.Error.AtLeast(check.Warning)).To(BeTrue())
+			Expect(check.Info.AtLeast(check.Warning)).To(BeFalse())
+
+			severity, err := check.ParseSeverity("Warning")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(severity).To(Equal(check.Warning))
+
+			_, err = check.ParseSeverity("fatal")
+			Expect(err).To(MatchError(ContainSubstring("invalid severity: fatal")))
+		})
+	})
+
+	Describe("Registered checks", func() {
+		It("names each built in rule and applies them by state", func() {
+			for _, r := range check.Builtin {
+				_, found := check.Lookup(r.Check)
+				Expect(found).To(BeTrue(), "built in rule names unregistered check: "+r.Check)
+			}
+
+			_, found := check.Lookup("not-a-check")
+			Expect(found).To(BeFalse())
+
+			Expect(len(check.ForState("", states.Implementable))).To(Equal(len(check.ForState("", states.Draft)) + 2))
+
+			_, err := check.Rule{Check: "not-a-check"}.That("")
+			Expect(err).To(MatchError(ContainSubstring("no check registered as: not-a-check")))
+		})
+
+		It("requires an approver who is not an author", func() {
+			meta := &metadatafakes.FakeKEP{}
+			meta.AuthorsReturns([]string{"jbeda"})
+			meta.ApproversReturns([]string{"@JBeda"})
+
+			Expect(check.ThatHasNonAuthorApprover(meta)).To(MatchError(ContainSubstring("no approver who is not also an author")))
+
+			meta.ApproversReturns([]string{"@JBeda", "calebamiles"})
+			Expect(check.ThatHasNonAuthorApprover(meta)).To(Succeed())
 		})
 	})
 
@@ -216,6 +347,13 @@ var _ = Describe("Checking Metadata", func() {
 
 			err = check.ThatAuthorIsNotApprover(meta)
 			Expect(err).ToNot(HaveOccurred())
+
+			By("comparing GitHub handles regardless of case or form")
+			meta.AuthorsReturns([]string{"A Smart Coder (@aSmartCoder)"})
+			meta.ApproversReturns([]string{"@asmartcoder"})
+
+			err = check.ThatAuthorIsNotApprover(meta)
+			Expect(err).To(HaveOccurred())
 		})
 	})
 
@@ -236,6 +374,13 @@ var _ = Describe("Checking Metadata", func() {
 
 			err = check.ThatAuthorIsNotReviewer(meta)
 			Expect(err).ToNot(HaveOccurred())
+
+			By("comparing GitHub handles regardless of case or form")
+			meta.AuthorsReturns([]string{"A Smart Coder (@aSmartCoder)"})
+			meta.ReviewersReturns([]string{"@asmartcoder"})
+
+			err = check.ThatAuthorIsNotReviewer(meta)
+			Expect(err).To(HaveOccurred())
 		})
 	})
 
@@ -383,6 +528,21 @@ var _ = Describe("Checking Metadata", func() {
 		})
 	})
 
+	Describe("Checking that development themes are known", func() {
+		It("ensures each development theme is in the theme registry", func() {
+			meta := &metadatafakes.FakeKEP{}
+			meta.DevelopmentThemesReturns([]string{themes.Stability})
+
+			err := check.ThatDevelopmentThemesAreKnown(meta)
+			Expect(err).ToNot(HaveOccurred())
+
+			meta.DevelopmentThemesReturns([]string{themes.Stability, "stabilty"})
+
+			err = check.ThatDevelopmentThemesAreKnown(meta)
+			Expect(err).To(MatchError(ContainSubstring("invalid development theme: stabilty")))
+		})
+	})
+
 	Describe("Checking that the KEP has a stability development theme", func() {
 		It("checks that a stability development theme has been set", func() {
 			meta := &metadatafakes.FakeKEP{}
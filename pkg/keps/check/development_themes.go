@@ -42,3 +42,17 @@ func ThatKEPHasStabilityTheme(meta metadata.KEP) error {
 	errs = multierror.Append(errs, fmt.Errorf("stability development theme: %s not set", themes.Stability))
 	return errs
 }
+
+// ThatDevelopmentThemesAreKnown ensures each development theme of the KEP is
+// listed in the theme registry, see themes.All
+func ThatDevelopmentThemesAreKnown(meta metadata.KEP) error {
+	var errs *multierror.Error
+
+	for _, theme := range meta.DevelopmentThemes() {
+		if theme != "" && !themes.Exists(theme) {
+			errs = multierror.Append(errs, fmt.Errorf("invalid development theme: %s. Not found in development themes from: %s", theme, themes.Source()))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
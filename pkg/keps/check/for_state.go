@@ -7,8 +7,29 @@ import (
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/states"
 )
 
+// ForState returns the checks a KEP in the given state, stored under
+// contentRoot, must satisfy: those of the Builtin rules which apply to the state
+func ForState(contentRoot string, state states.Name) []That {
+	checks := []That{}
+	for _, r := range Builtin {
+		if !r.AppliesTo(state) {
+			continue
+		}
+
+		c, err := r.That(contentRoot)
+		if err != nil {
+			panic(err) // Builtin only names registered checks
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks
+}
+
 func ThatIsValidForProvisionalState(meta metadata.KEP) error {
 	var errs *multierror.Error
 	var err error
@@ -0,0 +1,141 @@
+package check
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/calebamiles/keps/pkg/keps/states"
+)
+
+// A Registered check can be referred to by name, for example from a policy
+type Registered struct {
+	Name        string
+	Description string
+	Check       That // nil for checks of where a KEP is stored, see For
+
+	under func(contentRoot string) That
+}
+
+// For returns the registered check for KEPs stored under contentRoot
+func (r Registered) For(contentRoot string) That {
+	if r.under != nil {
+		return r.under(contentRoot)
+	}
+
+	return r.Check
+}
+
+var registry = map[string]Registered{}
+
+func register(name string, description string, c That) {
+	registry[name] = Registered{Name: name, Description: description, Check: c}
+}
+
+// registerUnder registers a check which depends on the content root a KEP is stored under
+func registerUnder(name string, description string, c func(contentRoot string) That) {
+	registry[name] = Registered{Name: name, Description: description, under: c}
+}
+
+func init() {
+	register("state-is-set", "the KEP has a known state", ThatStateIsSet)
+	register("sections-exist", "each section listed exists on disk with content", ThatAllSectionsExistWithContent)
+	register("sections-unique", "no section is listed more than once", ThatAllSectionsAreUnique)
+	register("sigs-exist", "the owning and participating SIGs exist", ThatAllSIGsExist)
+	register("subprojects-exist", "each affected subproject exists", ThatSubprojectsExist)
+	register("title-is-set", "the KEP has a title", ThatTitleIsSet)
+	register("authors-exist", "the KEP lists its authors", ThatAuthorsExist)
+	register("has-uuid", "the KEP has a valid UUID", ThatKEPHasUUID)
+	register("created-time-exists", "the KEP records when it was created", ThatCreatedTimeExists)
+	register("last-updated-after-created", "the KEP was last updated after it was created", ThatLastUpdatedAfterCreated)
+	registerUnder("location-matches-routing", "the owning SIG, subproject and scope match the KEP directory", ThatLocationMatchesRouting)
+	register("has-owning-sig", "the KEP has an owning SIG which exists", ThatHasOwningSIG)
+	register("has-reviewers", "the KEP lists reviewers", ThatThereAreReviewers)
+	register("has-approvers", "the KEP lists approvers", ThatThereAreApprovers)
+	register("has-editors", "the KEP lists editors", ThatThereAreEditors)
+	register("author-not-approver", "no author is also an approver", ThatAuthorIsNotApprover)
+	register("author-not-reviewer", "no author is also a reviewer", ThatAuthorIsNotReviewer)
+	register("non-author-approver", "at least one approver is not an author", ThatHasNonAuthorApprover)
+	register("has-development-themes", "the KEP lists development themes", ThatKEPHasDevelopmentThemes)
+	register("themes-known", "each development theme is a known theme", ThatDevelopmentThemesAreKnown)
+	register("stability-theme", "the KEP includes the stability development theme", ThatKEPHasStabilityTheme)
+	register("valid-for-provisional", "the KEP has the sections required of provisional KEPs and no short ID", ThatIsValidForProvisionalState)
+	register("valid-for-implementable", "the KEP has owners and the sections required of implementable KEPs", ThatIsValidForImplementableState)
+}
+
+// Lookup returns the check registered as name
+func Lookup(name string) (Registered, bool) {
+	r, found := registry[name]
+	return r, found
+}
+
+// Registry returns every registered check ordered by name
+func Registry() []Registered {
+	all := []Registered{}
+	for _, r := range registry {
+		all = append(all, r)
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	return all
+}
+
+// A Rule applies a registered check to KEPs in one of States, or to every KEP
+// when no states are given, reporting problems found with Severity
+type Rule struct {
+	Check    string
+	States   []states.Name
+	Severity Severity
+}
+
+// AppliesTo returns whether the rule applies to a KEP in state
+func (r Rule) AppliesTo(state states.Name) bool {
+	if len(r.States) == 0 {
+		return true
+	}
+
+	for _, s := range r.States {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// That returns the registered check named by the rule for KEPs stored under
+// contentRoot, reporting problems with the rule severity
+func (r Rule) That(contentRoot string) (That, error) {
+	registered, found := Lookup(r.Check)
+	if !found {
+		return nil, fmt.Errorf("no check registered as: %s", r.Check)
+	}
+
+	if r.Severity == Error || r.Severity == "" {
+		return registered.For(contentRoot), nil
+	}
+
+	return WithSeverity(r.Severity, registered.For(contentRoot)), nil
+}
+
+// Builtin are the rules every KEP is held to: the basic invariants, see
+// ThatAllBasicInvariantsAreSatisfied, advice reported as warnings and the
+// requirements of particular states
+var Builtin = []Rule{
+	{Check: "state-is-set", Severity: Error},
+	{Check: "sections-exist", Severity: Error},
+	{Check: "sections-unique", Severity: Error},
+	{Check: "sigs-exist", Severity: Error},
+	{Check: "title-is-set", Severity: Error},
+	{Check: "authors-exist", Severity: Error},
+	{Check: "has-uuid", Severity: Error},
+	{Check: "created-time-exists", Severity: Error},
+	{Check: "last-updated-after-created", Severity: Error},
+	{Check: "location-matches-routing", Severity: Error},
+	{Check: "author-not-approver", Severity: Warning},
+	{Check: "author-not-reviewer", Severity: Warning},
+	{Check: "themes-known", Severity: Warning},
+	{Check: "has-development-themes", Severity: Warning, States: []states.Name{states.Provisional, states.Implementable, states.Implemented}},
+	{Check: "valid-for-provisional", Severity: Error, States: []states.Name{states.Provisional}},
+	{Check: "valid-for-implementable", Severity: Error, States: []states.Name{states.Implementable}},
+}
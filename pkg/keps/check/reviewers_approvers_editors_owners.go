@@ -83,8 +83,8 @@ func ThatHasOwningSIG(meta metadata.KEP) error {
 	switch {
 	case meta.OwningSIG() == "":
 		errs = multierror.Append(errs, errors.New("Invalid owning SIG. Empty SIG information"))
-	case !sigs.Exists(meta.OwningSIG()):
-		errs = multierror.Append(errs, fmt.Errorf("Invalid owning SIG %s. No SIG information compiled in. Try updating?", meta.OwningSIG()))
+	case !sigs.GroupExists(meta.OwningSIG()):
+		errs = multierror.Append(errs, fmt.Errorf("Invalid owning SIG %s. Not found in SIG information from: %s. Try updating?", meta.OwningSIG(), sigs.Source()))
 	}
 
 	return errs.ErrorOrNil()
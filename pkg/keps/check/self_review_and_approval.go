@@ -19,12 +19,11 @@ func ThatAuthorIsNotApprover(meta metadata.KEP) error {
 	}
 
 	for _, author := range meta.Authors() {
-		inAuthorsSet[author] = true
+		inAuthorsSet[metadata.IdentityKey(author)] = true
 	}
 
-	//TODO downcase comparisons
 	for _, approver := range meta.Approvers() {
-		if inAuthorsSet[approver] {
+		if inAuthorsSet[metadata.IdentityKey(approver)] {
 			errs = multierror.Append(errs, fmt.Errorf("%s is listed as both an author and approver", approver))
 		}
 	}
@@ -42,15 +41,34 @@ func ThatAuthorIsNotReviewer(meta metadata.KEP) error {
 	}
 
 	for _, author := range meta.Authors() {
-		inAuthorsSet[author] = true
+		inAuthorsSet[metadata.IdentityKey(author)] = true
 	}
 
-	//TODO downcase comparisons
 	for _, reviewer := range meta.Reviewers() {
-		if inAuthorsSet[reviewer] {
+		if inAuthorsSet[metadata.IdentityKey(reviewer)] {
 			errs = multierror.Append(errs, fmt.Errorf("%s is listed as both an author and reviewer", reviewer))
 		}
 	}
 
 	return errs.ErrorOrNil()
 }
+
+// ThatHasNonAuthorApprover ensures that at least one approver is not also an
+// author of the KEP
+func ThatHasNonAuthorApprover(meta metadata.KEP) error {
+	var errs *multierror.Error
+
+	inAuthorsSet := map[string]bool{}
+	for _, author := range meta.Authors() {
+		inAuthorsSet[metadata.IdentityKey(author)] = true
+	}
+
+	for _, approver := range meta.Approvers() {
+		if !inAuthorsSet[metadata.IdentityKey(approver)] {
+			return nil
+		}
+	}
+
+	errs = multierror.Append(errs, errors.New("no approver who is not also an author"))
+	return errs
+}
@@ -0,0 +1,126 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+)
+
+// Severity describes how serious a problem found by a check is. Only problems
+// of Error severity prevent a KEP from being loaded or changing state
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+	Info    Severity = "info"
+)
+
+var severityRank = map[Severity]int{
+	Info:    1,
+	Warning: 2,
+	Error:   3,
+}
+
+// ParseSeverity returns the Severity named by s
+func ParseSeverity(s string) (Severity, error) {
+	severity := Severity(strings.ToLower(s))
+	if severityRank[severity] == 0 {
+		return "", fmt.Errorf("invalid severity: %s. Must be one of: %s, %s, %s", s, Error, Warning, Info)
+	}
+
+	return severity, nil
+}
+
+// AtLeast returns whether s is as serious as threshold
+func (s Severity) AtLeast(threshold Severity) bool {
+	return severityRank[s] >= severityRank[threshold]
+}
+
+// A Finding is a single problem found by a check. Checks report a Finding as
+// an error so that they remain a That
+type Finding struct {
+	Severity Severity
+	Message  string
+}
+
+func (f *Finding) Error() string { return f.Message }
+
+// WithSeverity returns a check reporting each problem found by c with the
+// given severity rather than as an Error
+func WithSeverity(severity Severity, c That) That {
+	return func(meta metadata.KEP) error {
+		var errs *multierror.Error
+
+		for _, f := range Findings(c(meta)) {
+			errs = multierror.Append(errs, &Finding{Severity: severity, Message: f.Message})
+		}
+
+		return errs.ErrorOrNil()
+	}
+}
+
+// Findings returns each problem reported in err, the result of a check.
+// Problems not reported with a severity are of Error severity
+func Findings(err error) []Finding {
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if merr, ok := err.(*multierror.Error); ok {
+		errs = merr.Errors
+	}
+
+	findings := []Finding{}
+	for _, e := range errs {
+		if f, ok := e.(*Finding); ok {
+			findings = append(findings, *f)
+			continue
+		}
+
+		findings = append(findings, Finding{Severity: Error, Message: e.Error()})
+	}
+
+	return findings
+}
+
+// Errors returns the problems of Error severity reported in err, the result
+// of a check, or nil when there are none
+func Errors(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if merr, ok := err.(*multierror.Error); ok {
+		errs = merr.Errors
+	}
+
+	var errorsOnly *multierror.Error
+	for _, e := range errs {
+		if f, ok := e.(*Finding); ok && f.Severity != Error {
+			continue
+		}
+
+		errorsOnly = multierror.Append(errorsOnly, e)
+	}
+
+	return errorsOnly.ErrorOrNil()
+}
+
+// BelowError returns the problems reported in err, the result of a check, which
+// are less serious than Error
+func BelowError(err error) []Finding {
+	findings := []Finding{}
+	for _, f := range Findings(err) {
+		if f.Severity != Error {
+			findings = append(findings, f)
+		}
+	}
+
+	return findings
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/calebamiles/keps/pkg/keps/check"
 	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/policy"
 	"github.com/calebamiles/keps/pkg/keps/sections"
 	"github.com/calebamiles/keps/pkg/keps/states"
 )
@@ -16,58 +17,57 @@ type Instance interface {
 	ShortID() int
 	Title() string
 	OwningSIG() string
+	AffectedSubprojects() []string
+	ParticipatingSIGs() []string
 	Authors() []string
+	Reviewers() []string
+	Approvers() []string
 	ContentDir() string
 	State() states.Name
 	Created() time.Time
 	LastUpdated() time.Time
 	Sections() []string
+	PullRequests() []metadata.PullRequest
+	Events() []metadata.Event
+	DevelopmentThemes() []string
 
 	// simple pass through mutators
 	AddApprovers(...string)
 	AddReviewers(...string)
+	SetParticipatingSIGs(...string)
+	SetAffectedSubprojects(...string)
+	SetRouting(metadata.RoutingInfoProvider)
+	AddPullRequest(metadata.PullRequest)
+	AddEvent(description string)
 
 	// heavy lifting mutators
 	SetState(states.Name) error
 
 	// consistency
 	AddChecks(...check.That)
-	Check() error
+	Check() error              // only problems of check.Error severity are returned
+	Warnings() []check.Finding // less serious problems found by the last check
 
 	// flush to disk
 	Persist() error
 }
 
-// New creates a new Instance from a sections.Collection and a metadata.KEP
-func New(meta metadata.KEP, existingEntries []sections.Entry) (Instance, error) {
-	k := &kep{
-		meta:    meta,
-		locker:  new(sync.RWMutex),
-		content: make(map[sections.Entry]bool),
-	}
-
-	checks := []check.That{check.ThatAllBasicInvariantsAreSatisfied}
-	switch meta.State() {
-	case states.Provisional:
-		checks = append(checks, check.ThatIsValidForProvisionalState)
-	case states.Implementable:
-		checks = append(checks, check.ThatIsValidForImplementableState)
-	}
-
-	checkAll := check.All(checks)
-	err := checkAll(meta)
+// New creates a new Instance from a sections.Collection and a metadata.KEP held
+// to the policy at contentRoot, no policy applies when contentRoot is empty
+func New(contentRoot string, meta metadata.KEP, existingEntries []sections.Entry) (Instance, error) {
+	k, err := newKEP(contentRoot, meta)
 	if err != nil {
 		return nil, err
 	}
 
-	k.checks = checks
 	k.addSections(existingEntries)
 
 	return k, nil
 }
 
-// Open returns an Instance based on information stored on disk at path
-func Open(path string) (Instance, error) {
+// Open returns an Instance based on information stored on disk at path held to
+// the policy at contentRoot, no policy applies when contentRoot is empty
+func Open(contentRoot string, path string) (Instance, error) {
 	meta, err := metadata.Open(path)
 	if err != nil {
 		return nil, err
@@ -78,37 +78,79 @@ func Open(path string) (Instance, error) {
 		return nil, err
 	}
 
-	checks := []check.That{check.ThatAllBasicInvariantsAreSatisfied}
-	switch meta.State() {
-	case states.Provisional:
-		checks = append(checks, check.ThatIsValidForProvisionalState)
-	case states.Implementable:
-		checks = append(checks, check.ThatIsValidForImplementableState)
-	}
-
-	checkAll := check.All(checks)
-	err = checkAll(meta)
+	k, err := newKEP(contentRoot, meta)
 	if err != nil {
 		return nil, err
 	}
 
+	k.addSections(sectionEntries)
+
+	return k, nil
+}
+
+// newKEP returns a kep for meta which satisfies its checks
+func newKEP(contentRoot string, meta metadata.KEP) (*kep, error) {
+	pol := &policy.Policy{}
+	if contentRoot != "" {
+		var err error
+		pol, err = policy.Load(contentRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	k := &kep{
-		meta:    meta,
-		locker:  new(sync.RWMutex),
-		content: make(map[sections.Entry]bool),
-		checks:  checks,
+		contentRoot:    contentRoot,
+		meta:           meta,
+		policy:         pol,
+		locker:         new(sync.RWMutex),
+		warningsLocker: new(sync.Mutex),
+		content:        make(map[sections.Entry]bool),
 	}
 
-	k.addSections(sectionEntries)
+	checks, err := k.checksFor(meta.State())
+	if err != nil {
+		return nil, err
+	}
+
+	k.checks = checks
+
+	err = k.check()
+	if err != nil {
+		return nil, err
+	}
 
 	return k, nil
 }
 
+// checksFor returns the checks a KEP in state must satisfy: those of the
+// check.Builtin rules and the policy rules for the state, along with any
+// checks added with AddChecks
+func (k *kep) checksFor(state states.Name) ([]check.That, error) {
+	policyChecks, err := k.policy.Checks(k.contentRoot, k.meta.OwningSIG(), state)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := append(check.ForState(k.contentRoot, state), policyChecks...)
+
+	return append(checks, k.addedChecks...), nil
+}
+
 type kep struct {
-	meta    metadata.KEP
-	content map[sections.Entry]bool
-	checks  []check.That
-	locker  *sync.RWMutex
+	contentRoot string
+	meta        metadata.KEP
+	policy      *policy.Policy
+	content     map[sections.Entry]bool
+	checks      []check.That
+	locker      *sync.RWMutex
+
+	// checks added with AddChecks, kept when the state changes
+	addedChecks []check.That
+
+	// problems less serious than errors found by the last check
+	warnings       []check.Finding
+	warningsLocker *sync.Mutex
 }
 
 func (k *kep) Persist() error {
@@ -165,46 +207,39 @@ func (k *kep) SetState(state states.Name) error {
 		return err
 	}
 
+	var newEntries []sections.Entry
 	switch state {
-	case states.Draft:
-		newEntries, err := sections.RenderMissingForProvisionalState(k.meta)
-		if err != nil {
-			return err
-		}
-
-		k.addSections(newEntries)
-
-		k.meta.SetState(states.Draft)
-
-		return nil
-
-	case states.Provisional:
-		newEntries, err := sections.RenderMissingForProvisionalState(k.meta)
-		if err != nil {
-			return err
-		}
-
-		k.checks = append(k.checks, check.ThatIsValidForProvisionalState) // allow anyone to check that the KEP remains valid
-		k.addSections(newEntries)
-
-		k.meta.SetState(states.Provisional)
-		return nil
-
+	case states.Draft, states.Provisional:
+		newEntries, err = sections.RenderMissingForProvisionalState(k.meta)
 	case states.Implementable:
-		newEntries, err := sections.RenderMissingForImplementableState(k.meta)
-		if err != nil {
-			return err
+		newEntries, err = sections.RenderMissingForImplementableState(k.meta)
+	case states.Implemented:
+		// only an implementable KEP has a plan which can have been implemented
+		if current := k.meta.State(); current != states.Implementable && current != states.Implemented {
+			return fmt.Errorf("cannot move a KEP from state: %s to state: %s, the KEP must be %s", current, state, states.Implementable)
 		}
 
-		k.checks = append(k.checks, check.ThatIsValidForImplementableState) // allow anyone to check that the KEP remains valid
-		k.addSections(newEntries)
-
-		k.meta.SetState(states.Implementable)
-		return nil
-
+		// implemented KEPs keep the sections of implementable KEPs
+		newEntries, err = sections.RenderMissingForImplementableState(k.meta)
 	default:
 		return fmt.Errorf("no transition rules exist for state: %s", state)
 	}
+
+	if err != nil {
+		return err
+	}
+
+	// allow anyone to check that the KEP remains valid for its new state
+	checks, err := k.checksFor(state)
+	if err != nil {
+		return err
+	}
+
+	k.checks = checks
+	k.addSections(newEntries)
+
+	k.meta.SetState(state)
+	return nil
 }
 
 func (k *kep) addSections(entries []sections.Entry) {
@@ -239,15 +274,34 @@ func (k *kep) Check() error {
 	return k.check()
 }
 
+// check returns the problems of Error severity found by the KEP checks,
+// keeping any other problems for Warnings
 func (k *kep) check() error {
 	checkAll := check.All(k.checks)
-	return checkAll(k.meta)
+	err := checkAll(k.meta)
+
+	k.warningsLocker.Lock()
+	defer k.warningsLocker.Unlock()
+
+	k.warnings = check.BelowError(err)
+
+	return check.Errors(err)
+}
+
+// Warnings returns the problems less serious than errors found the last time
+// the KEP was checked, these never prevent loading or changing the KEP
+func (k *kep) Warnings() []check.Finding {
+	k.warningsLocker.Lock()
+	defer k.warningsLocker.Unlock()
+
+	return append([]check.Finding{}, k.warnings...)
 }
 
 func (k *kep) AddChecks(checks ...check.That) {
 	k.locker.Lock()
 	defer k.locker.Unlock()
 
+	k.addedChecks = append(k.addedChecks, checks...)
 	k.checks = append(k.checks, checks...)
 }
 
@@ -265,6 +319,62 @@ func (k *kep) AddReviewers(reviewers ...string) {
 	k.meta.AddReviewers(reviewers)
 }
 
+func (k *kep) SetParticipatingSIGs(sigs ...string) {
+	k.locker.Lock()
+	defer k.locker.Unlock()
+
+	k.meta.SetParticipatingSIGs(sigs)
+}
+
+func (k *kep) SetAffectedSubprojects(subprojects ...string) {
+	k.locker.Lock()
+	defer k.locker.Unlock()
+
+	k.meta.SetAffectedSubprojects(subprojects)
+}
+
+func (k *kep) SetRouting(routingInfo metadata.RoutingInfoProvider) {
+	k.locker.Lock()
+	defer k.locker.Unlock()
+
+	k.meta.SetRouting(routingInfo)
+}
+
+func (k *kep) AddPullRequest(pr metadata.PullRequest) {
+	k.locker.Lock()
+	defer k.locker.Unlock()
+
+	k.meta.AddPullRequest(pr)
+}
+
+func (k *kep) PullRequests() []metadata.PullRequest {
+	k.locker.RLock()
+	defer k.locker.RUnlock()
+
+	return k.meta.PullRequests()
+}
+
+func (k *kep) AddEvent(description string) {
+	k.locker.Lock()
+	defer k.locker.Unlock()
+
+	k.meta.AddEvent(description)
+}
+
+func (k *kep) Events() []metadata.Event {
+	k.locker.RLock()
+	defer k.locker.RUnlock()
+
+	return k.meta.Events()
+}
+
+func (k *kep) DevelopmentThemes() []string {
+	k.locker.RLock()
+	defer k.locker.RUnlock()
+
+	return k.meta.DevelopmentThemes()
+}
+
 func (k *kep) UniqueID() string {
 	k.locker.RLock()
 	defer k.locker.RUnlock()
@@ -293,6 +403,20 @@ func (k *kep) OwningSIG() string {
 	return k.meta.OwningSIG()
 }
 
+func (k *kep) AffectedSubprojects() []string {
+	k.locker.RLock()
+	defer k.locker.RUnlock()
+
+	return k.meta.AffectedSubprojects()
+}
+
+func (k *kep) ParticipatingSIGs() []string {
+	k.locker.RLock()
+	defer k.locker.RUnlock()
+
+	return k.meta.ParticipatingSIGs()
+}
+
 func (k *kep) Authors() []string {
 	k.locker.RLock()
 	defer k.locker.RUnlock()
@@ -300,6 +424,20 @@ func (k *kep) Authors() []string {
 	return k.meta.Authors()
 }
 
+func (k *kep) Reviewers() []string {
+	k.locker.RLock()
+	defer k.locker.RUnlock()
+
+	return k.meta.Reviewers()
+}
+
+func (k *kep) Approvers() []string {
+	k.locker.RLock()
+	defer k.locker.RUnlock()
+
+	return k.meta.Approvers()
+}
+
 func (k *kep) State() states.Name {
 	k.locker.RLock()
 	defer k.locker.RUnlock()
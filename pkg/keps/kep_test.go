@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/calebamiles/keps/pkg/keps/check"
 	"github.com/calebamiles/keps/pkg/keps/metadata"
 	"github.com/calebamiles/keps/pkg/keps/metadata/metadatafakes"
 	"github.com/calebamiles/keps/pkg/keps/sections"
@@ -46,7 +47,7 @@ var _ = Describe("A KEP", func() {
 
 			By("returning an error if a required condition for KEP at a given state is not met")
 
-			_, err := keps.New(fakeMetadata, emptyContent)
+			_, err := keps.New("", fakeMetadata, emptyContent)
 			Expect(err).To(HaveOccurred(), "creating a KEP should return an error if the metadata is invalid for the given state")
 			Expect(err.Error()).To(ContainSubstring("missing Motivation"), "a valid KEP with `provisional` state should include a motivation")
 			Expect(err.Error()).To(ContainSubstring("missing Summary"), "a valid KEP with `provisional` state should include a summary")
@@ -81,6 +82,7 @@ var _ = Describe("A KEP", func() {
 			fakeMetadata := &metadatafakes.FakeKEP{}
 			fakeMetadata.AuthorsReturns(authors)
 			fakeMetadata.ContentDirReturns(contentDir)
+			fakeMetadata.KubernetesWideReturns(true)
 			fakeMetadata.CreatedReturns(before)
 			fakeMetadata.LastUpdatedReturns(now)
 			fakeMetadata.TitleReturns(title)
@@ -88,7 +90,7 @@ var _ = Describe("A KEP", func() {
 			fakeMetadata.UniqueIDReturns(uniqueID)
 			fakeMetadata.StateReturns(states.Draft) // use `draft` to avoid failing checks for `provisional` state
 
-			k, err := keps.New(fakeMetadata, fakeSections)
+			k, err := keps.New("", fakeMetadata, fakeSections)
 			Expect(err).ToNot(HaveOccurred(), "expected no error when creating a new KEP with existing sections")
 
 			includedSections := k.Sections()
@@ -109,6 +111,7 @@ var _ = Describe("A KEP", func() {
 			fakeMetadata := &metadatafakes.FakeKEP{}
 			fakeMetadata.AuthorsReturns(authors)
 			fakeMetadata.ContentDirReturns(contentDir)
+			fakeMetadata.KubernetesWideReturns(true)
 			fakeMetadata.CreatedReturns(before)
 			fakeMetadata.LastUpdatedReturns(now)
 			fakeMetadata.TitleReturns(title)
@@ -118,7 +121,7 @@ var _ = Describe("A KEP", func() {
 
 			emptySections := []sections.Entry{}
 
-			k, err := keps.New(fakeMetadata, emptySections)
+			k, err := keps.New("", fakeMetadata, emptySections)
 			Expect(err).ToNot(HaveOccurred(), "expected no error when creating a new KEP with valid metadata and no existing sections")
 
 			expectedCheckError := errors.New("an expected error occurred")
@@ -144,6 +147,35 @@ var _ = Describe("A KEP", func() {
 		})
 	})
 
+	Describe("#Warnings()", func() {
+		It("returns problems less serious than errors without failing checks", func() {
+			now := time.Now()
+			before := now.Add(-time.Hour)
+			contentDir := "content/kubernetes-wide/kubernetes-enhancement-proposal-proccess"
+
+			fakeMetadata := &metadatafakes.FakeKEP{}
+			fakeMetadata.AuthorsReturns([]string{"jbeda", "calebamiles"})
+			fakeMetadata.ReviewersReturns([]string{"@JBeda"})
+			fakeMetadata.ContentDirReturns(contentDir)
+			fakeMetadata.KubernetesWideReturns(true)
+			fakeMetadata.CreatedReturns(before)
+			fakeMetadata.LastUpdatedReturns(now)
+			fakeMetadata.TitleReturns("The Kubernetes Enhancement Proposal Process")
+			fakeMetadata.OwningSIGReturns("sig-architecture")
+			fakeMetadata.UniqueIDReturns(uuid.New().String())
+			fakeMetadata.StateReturns(states.Draft)
+
+			k, err := keps.New("", fakeMetadata, []sections.Entry{})
+			Expect(err).ToNot(HaveOccurred(), "expected warnings not to prevent creating a KEP")
+
+			Expect(k.Warnings()).To(ConsistOf(check.Finding{Severity: check.Warning, Message: "@JBeda is listed as both an author and reviewer"}))
+
+			k.AddChecks(check.WithSeverity(check.Info, func(_ metadata.KEP) error { return errors.New("some information") }))
+			Expect(k.Check()).To(Succeed())
+			Expect(k.Warnings()).To(HaveLen(2))
+		})
+	})
+
 	Describe("#SetState()", func() {
 		It("attempts to set the state on the KEP", func() {
 			now := time.Now()
@@ -157,6 +189,7 @@ var _ = Describe("A KEP", func() {
 			fakeMetadata := &metadatafakes.FakeKEP{}
 			fakeMetadata.AuthorsReturns(authors)
 			fakeMetadata.ContentDirReturns(contentDir)
+			fakeMetadata.KubernetesWideReturns(true)
 			fakeMetadata.CreatedReturns(before)
 			fakeMetadata.LastUpdatedReturns(now)
 			fakeMetadata.TitleReturns(title)
@@ -164,7 +197,7 @@ var _ = Describe("A KEP", func() {
 			fakeMetadata.UniqueIDReturns(uniqueID)
 			fakeMetadata.StateReturns(states.Draft) // use `draft` to avoid failing checks for `provisional` state
 
-			k, err := keps.New(fakeMetadata, []sections.Entry{})
+			k, err := keps.New("", fakeMetadata, []sections.Entry{})
 			Expect(err).ToNot(HaveOccurred(), "expected no error when creating a KEP with valid metadata and no sections")
 
 			By("adding any missing sections and running consistency checks for the desired state")
@@ -175,6 +208,29 @@ var _ = Describe("A KEP", func() {
 			includedSections := k.Sections()
 			Expect(includedSections).To(ConsistOf(sections.Summary, sections.Motivation), "expected KEP to have two sections: Summary, and Motivation")
 		})
+
+		It("only marks implementable KEPs implemented", func() {
+			now := time.Now()
+			before := now.Add(-time.Hour)
+
+			fakeMetadata := &metadatafakes.FakeKEP{}
+			fakeMetadata.AuthorsReturns([]string{"jbeda", "calebamiles"})
+			fakeMetadata.ContentDirReturns("content/kubernetes-wide/kubernetes-enhancement-proposal-proccess")
+			fakeMetadata.KubernetesWideReturns(true)
+			fakeMetadata.CreatedReturns(before)
+			fakeMetadata.LastUpdatedReturns(now)
+			fakeMetadata.TitleReturns("The Kubernetes Enhancement Proposal Process")
+			fakeMetadata.OwningSIGReturns("sig-architecture")
+			fakeMetadata.UniqueIDReturns(uuid.New().String())
+			fakeMetadata.StateReturns(states.Draft)
+
+			k, err := keps.New("", fakeMetadata, []sections.Entry{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = k.SetState(states.Implemented)
+			Expect(err).To(MatchError("cannot move a KEP from state: draft to state: implemented, the KEP must be implementable"))
+			Expect(fakeMetadata.SetStateCallCount()).To(BeZero(), "expected the state to be left alone")
+		})
 	})
 
 	Describe("Reading metadata from an instance", func() {
@@ -190,6 +246,7 @@ var _ = Describe("A KEP", func() {
 			fakeMetadata := &metadatafakes.FakeKEP{}
 			fakeMetadata.AuthorsReturns(authors)
 			fakeMetadata.ContentDirReturns(contentDir)
+			fakeMetadata.KubernetesWideReturns(true)
 			fakeMetadata.CreatedReturns(before)
 			fakeMetadata.LastUpdatedReturns(now)
 			fakeMetadata.TitleReturns(title)
@@ -201,7 +258,7 @@ var _ = Describe("A KEP", func() {
 
 			By("exposing a limited set of fields of KEP metadata")
 
-			k, err := keps.New(fakeMetadata, fakeContent)
+			k, err := keps.New("", fakeMetadata, fakeContent)
 			Expect(err).ToNot(HaveOccurred(), "creating a KEP with keps.New() should not return an error with valid imput")
 
 			Expect(k.UniqueID()).To(Equal(uniqueID), "a KEP should expose the UUID of its metadata")
@@ -226,10 +283,15 @@ var _ = Describe("A KEP", func() {
 			title := "The Kubernetes Enhancement Proposal Process"
 			owningSIG := "sig-architecture"
 			authors := []string{"jbeda", "calebamiles"}
+			contentDir := filepath.Join(tmpDir, "kubernetes-wide", "kubernetes-enhancement-proposal-proccess")
+
+			err = os.MkdirAll(contentDir, os.ModePerm)
+			Expect(err).ToNot(HaveOccurred())
 
 			fakeMetadata := &metadatafakes.FakeKEP{}
 			fakeMetadata.AuthorsReturns(authors)
-			fakeMetadata.ContentDirReturns(tmpDir)
+			fakeMetadata.ContentDirReturns(contentDir)
+			fakeMetadata.KubernetesWideReturns(true)
 			fakeMetadata.CreatedReturns(before)
 			fakeMetadata.LastUpdatedReturns(now)
 			fakeMetadata.TitleReturns(title)
@@ -257,7 +319,7 @@ var _ = Describe("A KEP", func() {
 
 			fakeSections := []sections.Entry{sectionOne, sectionTwo}
 
-			kep, err := keps.New(fakeMetadata, fakeSections)
+			kep, err := keps.New("", fakeMetadata, fakeSections)
 			Expect(err).ToNot(HaveOccurred(), "creating a new KEP with valid metadata and no section content should not return error")
 
 			By("persisting KEP metadata")
@@ -275,7 +337,7 @@ var _ = Describe("A KEP", func() {
 			err = kep.Persist()
 			Expect(err).ToNot(HaveOccurred(), "expected no error when persisting a valid KEP")
 
-			expectedReadmePath := filepath.Join(tmpDir, "README.md")
+			expectedReadmePath := filepath.Join(contentDir, "README.md")
 			Expect(expectedReadmePath).To(BeARegularFile(), "expected README.md to be autogenerated during Persist()")
 		})
 
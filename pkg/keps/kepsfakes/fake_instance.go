@@ -2,12 +2,13 @@
 package kepsfakes
 
 import (
-	sync "sync"
-	time "time"
+	"sync"
+	"time"
 
-	keps "github.com/calebamiles/keps/pkg/keps"
-	check "github.com/calebamiles/keps/pkg/keps/check"
-	states "github.com/calebamiles/keps/pkg/keps/states"
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/states"
 )
 
 type FakeInstance struct {
@@ -21,11 +22,41 @@ type FakeInstance struct {
 	addChecksArgsForCall []struct {
 		arg1 []check.That
 	}
+	AddEventStub        func(string)
+	addEventMutex       sync.RWMutex
+	addEventArgsForCall []struct {
+		arg1 string
+	}
+	AddPullRequestStub        func(metadata.PullRequest)
+	addPullRequestMutex       sync.RWMutex
+	addPullRequestArgsForCall []struct {
+		arg1 metadata.PullRequest
+	}
 	AddReviewersStub        func(...string)
 	addReviewersMutex       sync.RWMutex
 	addReviewersArgsForCall []struct {
 		arg1 []string
 	}
+	AffectedSubprojectsStub        func() []string
+	affectedSubprojectsMutex       sync.RWMutex
+	affectedSubprojectsArgsForCall []struct {
+	}
+	affectedSubprojectsReturns struct {
+		result1 []string
+	}
+	affectedSubprojectsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	ApproversStub        func() []string
+	approversMutex       sync.RWMutex
+	approversArgsForCall []struct {
+	}
+	approversReturns struct {
+		result1 []string
+	}
+	approversReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	AuthorsStub        func() []string
 	authorsMutex       sync.RWMutex
 	authorsArgsForCall []struct {
@@ -66,6 +97,26 @@ type FakeInstance struct {
 	createdReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	DevelopmentThemesStub        func() []string
+	developmentThemesMutex       sync.RWMutex
+	developmentThemesArgsForCall []struct {
+	}
+	developmentThemesReturns struct {
+		result1 []string
+	}
+	developmentThemesReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	EventsStub        func() []metadata.Event
+	eventsMutex       sync.RWMutex
+	eventsArgsForCall []struct {
+	}
+	eventsReturns struct {
+		result1 []metadata.Event
+	}
+	eventsReturnsOnCall map[int]struct {
+		result1 []metadata.Event
+	}
 	LastUpdatedStub        func() time.Time
 	lastUpdatedMutex       sync.RWMutex
 	lastUpdatedArgsForCall []struct {
@@ -86,6 +137,16 @@ type FakeInstance struct {
 	owningSIGReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ParticipatingSIGsStub        func() []string
+	participatingSIGsMutex       sync.RWMutex
+	participatingSIGsArgsForCall []struct {
+	}
+	participatingSIGsReturns struct {
+		result1 []string
+	}
+	participatingSIGsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	PersistStub        func() error
 	persistMutex       sync.RWMutex
 	persistArgsForCall []struct {
@@ -96,6 +157,26 @@ type FakeInstance struct {
 	persistReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PullRequestsStub        func() []metadata.PullRequest
+	pullRequestsMutex       sync.RWMutex
+	pullRequestsArgsForCall []struct {
+	}
+	pullRequestsReturns struct {
+		result1 []metadata.PullRequest
+	}
+	pullRequestsReturnsOnCall map[int]struct {
+		result1 []metadata.PullRequest
+	}
+	ReviewersStub        func() []string
+	reviewersMutex       sync.RWMutex
+	reviewersArgsForCall []struct {
+	}
+	reviewersReturns struct {
+		result1 []string
+	}
+	reviewersReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	SectionsStub        func() []string
 	sectionsMutex       sync.RWMutex
 	sectionsArgsForCall []struct {
@@ -106,6 +187,21 @@ type FakeInstance struct {
 	sectionsReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	SetAffectedSubprojectsStub        func(...string)
+	setAffectedSubprojectsMutex       sync.RWMutex
+	setAffectedSubprojectsArgsForCall []struct {
+		arg1 []string
+	}
+	SetParticipatingSIGsStub        func(...string)
+	setParticipatingSIGsMutex       sync.RWMutex
+	setParticipatingSIGsArgsForCall []struct {
+		arg1 []string
+	}
+	SetRoutingStub        func(metadata.RoutingInfoProvider)
+	setRoutingMutex       sync.RWMutex
+	setRoutingArgsForCall []struct {
+		arg1 metadata.RoutingInfoProvider
+	}
 	SetStateStub        func(states.Name) error
 	setStateMutex       sync.RWMutex
 	setStateArgsForCall []struct {
@@ -157,6 +253,16 @@ type FakeInstance struct {
 	uniqueIDReturnsOnCall map[int]struct {
 		result1 string
 	}
+	WarningsStub        func() []check.Finding
+	warningsMutex       sync.RWMutex
+	warningsArgsForCall []struct {
+	}
+	warningsReturns struct {
+		result1 []check.Finding
+	}
+	warningsReturnsOnCall map[int]struct {
+		result1 []check.Finding
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -166,9 +272,10 @@ func (fake *FakeInstance) AddApprovers(arg1 ...string) {
 	fake.addApproversArgsForCall = append(fake.addApproversArgsForCall, struct {
 		arg1 []string
 	}{arg1})
+	stub := fake.AddApproversStub
 	fake.recordInvocation("AddApprovers", []interface{}{arg1})
 	fake.addApproversMutex.Unlock()
-	if fake.AddApproversStub != nil {
+	if stub != nil {
 		fake.AddApproversStub(arg1...)
 	}
 }
@@ -197,9 +304,10 @@ func (fake *FakeInstance) AddChecks(arg1 ...check.That) {
 	fake.addChecksArgsForCall = append(fake.addChecksArgsForCall, struct {
 		arg1 []check.That
 	}{arg1})
+	stub := fake.AddChecksStub
 	fake.recordInvocation("AddChecks", []interface{}{arg1})
 	fake.addChecksMutex.Unlock()
-	if fake.AddChecksStub != nil {
+	if stub != nil {
 		fake.AddChecksStub(arg1...)
 	}
 }
@@ -223,14 +331,79 @@ func (fake *FakeInstance) AddChecksArgsForCall(i int) []check.That {
 	return argsForCall.arg1
 }
 
+func (fake *FakeInstance) AddEvent(arg1 string) {
+	fake.addEventMutex.Lock()
+	fake.addEventArgsForCall = append(fake.addEventArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.AddEventStub
+	fake.recordInvocation("AddEvent", []interface{}{arg1})
+	fake.addEventMutex.Unlock()
+	if stub != nil {
+		fake.AddEventStub(arg1)
+	}
+}
+
+func (fake *FakeInstance) AddEventCallCount() int {
+	fake.addEventMutex.RLock()
+	defer fake.addEventMutex.RUnlock()
+	return len(fake.addEventArgsForCall)
+}
+
+func (fake *FakeInstance) AddEventCalls(stub func(string)) {
+	fake.addEventMutex.Lock()
+	defer fake.addEventMutex.Unlock()
+	fake.AddEventStub = stub
+}
+
+func (fake *FakeInstance) AddEventArgsForCall(i int) string {
+	fake.addEventMutex.RLock()
+	defer fake.addEventMutex.RUnlock()
+	argsForCall := fake.addEventArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInstance) AddPullRequest(arg1 metadata.PullRequest) {
+	fake.addPullRequestMutex.Lock()
+	fake.addPullRequestArgsForCall = append(fake.addPullRequestArgsForCall, struct {
+		arg1 metadata.PullRequest
+	}{arg1})
+	stub := fake.AddPullRequestStub
+	fake.recordInvocation("AddPullRequest", []interface{}{arg1})
+	fake.addPullRequestMutex.Unlock()
+	if stub != nil {
+		fake.AddPullRequestStub(arg1)
+	}
+}
+
+func (fake *FakeInstance) AddPullRequestCallCount() int {
+	fake.addPullRequestMutex.RLock()
+	defer fake.addPullRequestMutex.RUnlock()
+	return len(fake.addPullRequestArgsForCall)
+}
+
+func (fake *FakeInstance) AddPullRequestCalls(stub func(metadata.PullRequest)) {
+	fake.addPullRequestMutex.Lock()
+	defer fake.addPullRequestMutex.Unlock()
+	fake.AddPullRequestStub = stub
+}
+
+func (fake *FakeInstance) AddPullRequestArgsForCall(i int) metadata.PullRequest {
+	fake.addPullRequestMutex.RLock()
+	defer fake.addPullRequestMutex.RUnlock()
+	argsForCall := fake.addPullRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeInstance) AddReviewers(arg1 ...string) {
 	fake.addReviewersMutex.Lock()
 	fake.addReviewersArgsForCall = append(fake.addReviewersArgsForCall, struct {
 		arg1 []string
 	}{arg1})
+	stub := fake.AddReviewersStub
 	fake.recordInvocation("AddReviewers", []interface{}{arg1})
 	fake.addReviewersMutex.Unlock()
-	if fake.AddReviewersStub != nil {
+	if stub != nil {
 		fake.AddReviewersStub(arg1...)
 	}
 }
@@ -254,20 +427,127 @@ func (fake *FakeInstance) AddReviewersArgsForCall(i int) []string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeInstance) AffectedSubprojects() []string {
+	fake.affectedSubprojectsMutex.Lock()
+	ret, specificReturn := fake.affectedSubprojectsReturnsOnCall[len(fake.affectedSubprojectsArgsForCall)]
+	fake.affectedSubprojectsArgsForCall = append(fake.affectedSubprojectsArgsForCall, struct {
+	}{})
+	stub := fake.AffectedSubprojectsStub
+	fakeReturns := fake.affectedSubprojectsReturns
+	fake.recordInvocation("AffectedSubprojects", []interface{}{})
+	fake.affectedSubprojectsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) AffectedSubprojectsCallCount() int {
+	fake.affectedSubprojectsMutex.RLock()
+	defer fake.affectedSubprojectsMutex.RUnlock()
+	return len(fake.affectedSubprojectsArgsForCall)
+}
+
+func (fake *FakeInstance) AffectedSubprojectsCalls(stub func() []string) {
+	fake.affectedSubprojectsMutex.Lock()
+	defer fake.affectedSubprojectsMutex.Unlock()
+	fake.AffectedSubprojectsStub = stub
+}
+
+func (fake *FakeInstance) AffectedSubprojectsReturns(result1 []string) {
+	fake.affectedSubprojectsMutex.Lock()
+	defer fake.affectedSubprojectsMutex.Unlock()
+	fake.AffectedSubprojectsStub = nil
+	fake.affectedSubprojectsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeInstance) AffectedSubprojectsReturnsOnCall(i int, result1 []string) {
+	fake.affectedSubprojectsMutex.Lock()
+	defer fake.affectedSubprojectsMutex.Unlock()
+	fake.AffectedSubprojectsStub = nil
+	if fake.affectedSubprojectsReturnsOnCall == nil {
+		fake.affectedSubprojectsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.affectedSubprojectsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeInstance) Approvers() []string {
+	fake.approversMutex.Lock()
+	ret, specificReturn := fake.approversReturnsOnCall[len(fake.approversArgsForCall)]
+	fake.approversArgsForCall = append(fake.approversArgsForCall, struct {
+	}{})
+	stub := fake.ApproversStub
+	fakeReturns := fake.approversReturns
+	fake.recordInvocation("Approvers", []interface{}{})
+	fake.approversMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) ApproversCallCount() int {
+	fake.approversMutex.RLock()
+	defer fake.approversMutex.RUnlock()
+	return len(fake.approversArgsForCall)
+}
+
+func (fake *FakeInstance) ApproversCalls(stub func() []string) {
+	fake.approversMutex.Lock()
+	defer fake.approversMutex.Unlock()
+	fake.ApproversStub = stub
+}
+
+func (fake *FakeInstance) ApproversReturns(result1 []string) {
+	fake.approversMutex.Lock()
+	defer fake.approversMutex.Unlock()
+	fake.ApproversStub = nil
+	fake.approversReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeInstance) ApproversReturnsOnCall(i int, result1 []string) {
+	fake.approversMutex.Lock()
+	defer fake.approversMutex.Unlock()
+	fake.ApproversStub = nil
+	if fake.approversReturnsOnCall == nil {
+		fake.approversReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.approversReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeInstance) Authors() []string {
 	fake.authorsMutex.Lock()
 	ret, specificReturn := fake.authorsReturnsOnCall[len(fake.authorsArgsForCall)]
 	fake.authorsArgsForCall = append(fake.authorsArgsForCall, struct {
 	}{})
+	stub := fake.AuthorsStub
+	fakeReturns := fake.authorsReturns
 	fake.recordInvocation("Authors", []interface{}{})
 	fake.authorsMutex.Unlock()
-	if fake.AuthorsStub != nil {
-		return fake.AuthorsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.authorsReturns
 	return fakeReturns.result1
 }
 
@@ -311,15 +591,16 @@ func (fake *FakeInstance) Check() error {
 	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
 	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
 	}{})
+	stub := fake.CheckStub
+	fakeReturns := fake.checkReturns
 	fake.recordInvocation("Check", []interface{}{})
 	fake.checkMutex.Unlock()
-	if fake.CheckStub != nil {
-		return fake.CheckStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.checkReturns
 	return fakeReturns.result1
 }
 
@@ -363,15 +644,16 @@ func (fake *FakeInstance) ContentDir() string {
 	ret, specificReturn := fake.contentDirReturnsOnCall[len(fake.contentDirArgsForCall)]
 	fake.contentDirArgsForCall = append(fake.contentDirArgsForCall, struct {
 	}{})
+	stub := fake.ContentDirStub
+	fakeReturns := fake.contentDirReturns
 	fake.recordInvocation("ContentDir", []interface{}{})
 	fake.contentDirMutex.Unlock()
-	if fake.ContentDirStub != nil {
-		return fake.ContentDirStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.contentDirReturns
 	return fakeReturns.result1
 }
 
@@ -415,15 +697,16 @@ func (fake *FakeInstance) Created() time.Time {
 	ret, specificReturn := fake.createdReturnsOnCall[len(fake.createdArgsForCall)]
 	fake.createdArgsForCall = append(fake.createdArgsForCall, struct {
 	}{})
+	stub := fake.CreatedStub
+	fakeReturns := fake.createdReturns
 	fake.recordInvocation("Created", []interface{}{})
 	fake.createdMutex.Unlock()
-	if fake.CreatedStub != nil {
-		return fake.CreatedStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.createdReturns
 	return fakeReturns.result1
 }
 
@@ -462,20 +745,127 @@ func (fake *FakeInstance) CreatedReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeInstance) DevelopmentThemes() []string {
+	fake.developmentThemesMutex.Lock()
+	ret, specificReturn := fake.developmentThemesReturnsOnCall[len(fake.developmentThemesArgsForCall)]
+	fake.developmentThemesArgsForCall = append(fake.developmentThemesArgsForCall, struct {
+	}{})
+	stub := fake.DevelopmentThemesStub
+	fakeReturns := fake.developmentThemesReturns
+	fake.recordInvocation("DevelopmentThemes", []interface{}{})
+	fake.developmentThemesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) DevelopmentThemesCallCount() int {
+	fake.developmentThemesMutex.RLock()
+	defer fake.developmentThemesMutex.RUnlock()
+	return len(fake.developmentThemesArgsForCall)
+}
+
+func (fake *FakeInstance) DevelopmentThemesCalls(stub func() []string) {
+	fake.developmentThemesMutex.Lock()
+	defer fake.developmentThemesMutex.Unlock()
+	fake.DevelopmentThemesStub = stub
+}
+
+func (fake *FakeInstance) DevelopmentThemesReturns(result1 []string) {
+	fake.developmentThemesMutex.Lock()
+	defer fake.developmentThemesMutex.Unlock()
+	fake.DevelopmentThemesStub = nil
+	fake.developmentThemesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeInstance) DevelopmentThemesReturnsOnCall(i int, result1 []string) {
+	fake.developmentThemesMutex.Lock()
+	defer fake.developmentThemesMutex.Unlock()
+	fake.DevelopmentThemesStub = nil
+	if fake.developmentThemesReturnsOnCall == nil {
+		fake.developmentThemesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.developmentThemesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeInstance) Events() []metadata.Event {
+	fake.eventsMutex.Lock()
+	ret, specificReturn := fake.eventsReturnsOnCall[len(fake.eventsArgsForCall)]
+	fake.eventsArgsForCall = append(fake.eventsArgsForCall, struct {
+	}{})
+	stub := fake.EventsStub
+	fakeReturns := fake.eventsReturns
+	fake.recordInvocation("Events", []interface{}{})
+	fake.eventsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) EventsCallCount() int {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	return len(fake.eventsArgsForCall)
+}
+
+func (fake *FakeInstance) EventsCalls(stub func() []metadata.Event) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = stub
+}
+
+func (fake *FakeInstance) EventsReturns(result1 []metadata.Event) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	fake.eventsReturns = struct {
+		result1 []metadata.Event
+	}{result1}
+}
+
+func (fake *FakeInstance) EventsReturnsOnCall(i int, result1 []metadata.Event) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	if fake.eventsReturnsOnCall == nil {
+		fake.eventsReturnsOnCall = make(map[int]struct {
+			result1 []metadata.Event
+		})
+	}
+	fake.eventsReturnsOnCall[i] = struct {
+		result1 []metadata.Event
+	}{result1}
+}
+
 func (fake *FakeInstance) LastUpdated() time.Time {
 	fake.lastUpdatedMutex.Lock()
 	ret, specificReturn := fake.lastUpdatedReturnsOnCall[len(fake.lastUpdatedArgsForCall)]
 	fake.lastUpdatedArgsForCall = append(fake.lastUpdatedArgsForCall, struct {
 	}{})
+	stub := fake.LastUpdatedStub
+	fakeReturns := fake.lastUpdatedReturns
 	fake.recordInvocation("LastUpdated", []interface{}{})
 	fake.lastUpdatedMutex.Unlock()
-	if fake.LastUpdatedStub != nil {
-		return fake.LastUpdatedStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.lastUpdatedReturns
 	return fakeReturns.result1
 }
 
@@ -519,15 +909,16 @@ func (fake *FakeInstance) OwningSIG() string {
 	ret, specificReturn := fake.owningSIGReturnsOnCall[len(fake.owningSIGArgsForCall)]
 	fake.owningSIGArgsForCall = append(fake.owningSIGArgsForCall, struct {
 	}{})
+	stub := fake.OwningSIGStub
+	fakeReturns := fake.owningSIGReturns
 	fake.recordInvocation("OwningSIG", []interface{}{})
 	fake.owningSIGMutex.Unlock()
-	if fake.OwningSIGStub != nil {
-		return fake.OwningSIGStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.owningSIGReturns
 	return fakeReturns.result1
 }
 
@@ -566,20 +957,74 @@ func (fake *FakeInstance) OwningSIGReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeInstance) ParticipatingSIGs() []string {
+	fake.participatingSIGsMutex.Lock()
+	ret, specificReturn := fake.participatingSIGsReturnsOnCall[len(fake.participatingSIGsArgsForCall)]
+	fake.participatingSIGsArgsForCall = append(fake.participatingSIGsArgsForCall, struct {
+	}{})
+	stub := fake.ParticipatingSIGsStub
+	fakeReturns := fake.participatingSIGsReturns
+	fake.recordInvocation("ParticipatingSIGs", []interface{}{})
+	fake.participatingSIGsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) ParticipatingSIGsCallCount() int {
+	fake.participatingSIGsMutex.RLock()
+	defer fake.participatingSIGsMutex.RUnlock()
+	return len(fake.participatingSIGsArgsForCall)
+}
+
+func (fake *FakeInstance) ParticipatingSIGsCalls(stub func() []string) {
+	fake.participatingSIGsMutex.Lock()
+	defer fake.participatingSIGsMutex.Unlock()
+	fake.ParticipatingSIGsStub = stub
+}
+
+func (fake *FakeInstance) ParticipatingSIGsReturns(result1 []string) {
+	fake.participatingSIGsMutex.Lock()
+	defer fake.participatingSIGsMutex.Unlock()
+	fake.ParticipatingSIGsStub = nil
+	fake.participatingSIGsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeInstance) ParticipatingSIGsReturnsOnCall(i int, result1 []string) {
+	fake.participatingSIGsMutex.Lock()
+	defer fake.participatingSIGsMutex.Unlock()
+	fake.ParticipatingSIGsStub = nil
+	if fake.participatingSIGsReturnsOnCall == nil {
+		fake.participatingSIGsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.participatingSIGsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeInstance) Persist() error {
 	fake.persistMutex.Lock()
 	ret, specificReturn := fake.persistReturnsOnCall[len(fake.persistArgsForCall)]
 	fake.persistArgsForCall = append(fake.persistArgsForCall, struct {
 	}{})
+	stub := fake.PersistStub
+	fakeReturns := fake.persistReturns
 	fake.recordInvocation("Persist", []interface{}{})
 	fake.persistMutex.Unlock()
-	if fake.PersistStub != nil {
-		return fake.PersistStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.persistReturns
 	return fakeReturns.result1
 }
 
@@ -618,20 +1063,127 @@ func (fake *FakeInstance) PersistReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInstance) PullRequests() []metadata.PullRequest {
+	fake.pullRequestsMutex.Lock()
+	ret, specificReturn := fake.pullRequestsReturnsOnCall[len(fake.pullRequestsArgsForCall)]
+	fake.pullRequestsArgsForCall = append(fake.pullRequestsArgsForCall, struct {
+	}{})
+	stub := fake.PullRequestsStub
+	fakeReturns := fake.pullRequestsReturns
+	fake.recordInvocation("PullRequests", []interface{}{})
+	fake.pullRequestsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) PullRequestsCallCount() int {
+	fake.pullRequestsMutex.RLock()
+	defer fake.pullRequestsMutex.RUnlock()
+	return len(fake.pullRequestsArgsForCall)
+}
+
+func (fake *FakeInstance) PullRequestsCalls(stub func() []metadata.PullRequest) {
+	fake.pullRequestsMutex.Lock()
+	defer fake.pullRequestsMutex.Unlock()
+	fake.PullRequestsStub = stub
+}
+
+func (fake *FakeInstance) PullRequestsReturns(result1 []metadata.PullRequest) {
+	fake.pullRequestsMutex.Lock()
+	defer fake.pullRequestsMutex.Unlock()
+	fake.PullRequestsStub = nil
+	fake.pullRequestsReturns = struct {
+		result1 []metadata.PullRequest
+	}{result1}
+}
+
+func (fake *FakeInstance) PullRequestsReturnsOnCall(i int, result1 []metadata.PullRequest) {
+	fake.pullRequestsMutex.Lock()
+	defer fake.pullRequestsMutex.Unlock()
+	fake.PullRequestsStub = nil
+	if fake.pullRequestsReturnsOnCall == nil {
+		fake.pullRequestsReturnsOnCall = make(map[int]struct {
+			result1 []metadata.PullRequest
+		})
+	}
+	fake.pullRequestsReturnsOnCall[i] = struct {
+		result1 []metadata.PullRequest
+	}{result1}
+}
+
+func (fake *FakeInstance) Reviewers() []string {
+	fake.reviewersMutex.Lock()
+	ret, specificReturn := fake.reviewersReturnsOnCall[len(fake.reviewersArgsForCall)]
+	fake.reviewersArgsForCall = append(fake.reviewersArgsForCall, struct {
+	}{})
+	stub := fake.ReviewersStub
+	fakeReturns := fake.reviewersReturns
+	fake.recordInvocation("Reviewers", []interface{}{})
+	fake.reviewersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) ReviewersCallCount() int {
+	fake.reviewersMutex.RLock()
+	defer fake.reviewersMutex.RUnlock()
+	return len(fake.reviewersArgsForCall)
+}
+
+func (fake *FakeInstance) ReviewersCalls(stub func() []string) {
+	fake.reviewersMutex.Lock()
+	defer fake.reviewersMutex.Unlock()
+	fake.ReviewersStub = stub
+}
+
+func (fake *FakeInstance) ReviewersReturns(result1 []string) {
+	fake.reviewersMutex.Lock()
+	defer fake.reviewersMutex.Unlock()
+	fake.ReviewersStub = nil
+	fake.reviewersReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeInstance) ReviewersReturnsOnCall(i int, result1 []string) {
+	fake.reviewersMutex.Lock()
+	defer fake.reviewersMutex.Unlock()
+	fake.ReviewersStub = nil
+	if fake.reviewersReturnsOnCall == nil {
+		fake.reviewersReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.reviewersReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeInstance) Sections() []string {
 	fake.sectionsMutex.Lock()
 	ret, specificReturn := fake.sectionsReturnsOnCall[len(fake.sectionsArgsForCall)]
 	fake.sectionsArgsForCall = append(fake.sectionsArgsForCall, struct {
 	}{})
+	stub := fake.SectionsStub
+	fakeReturns := fake.sectionsReturns
 	fake.recordInvocation("Sections", []interface{}{})
 	fake.sectionsMutex.Unlock()
-	if fake.SectionsStub != nil {
-		return fake.SectionsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.sectionsReturns
 	return fakeReturns.result1
 }
 
@@ -670,21 +1222,118 @@ func (fake *FakeInstance) SectionsReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeInstance) SetAffectedSubprojects(arg1 ...string) {
+	fake.setAffectedSubprojectsMutex.Lock()
+	fake.setAffectedSubprojectsArgsForCall = append(fake.setAffectedSubprojectsArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.SetAffectedSubprojectsStub
+	fake.recordInvocation("SetAffectedSubprojects", []interface{}{arg1})
+	fake.setAffectedSubprojectsMutex.Unlock()
+	if stub != nil {
+		fake.SetAffectedSubprojectsStub(arg1...)
+	}
+}
+
+func (fake *FakeInstance) SetAffectedSubprojectsCallCount() int {
+	fake.setAffectedSubprojectsMutex.RLock()
+	defer fake.setAffectedSubprojectsMutex.RUnlock()
+	return len(fake.setAffectedSubprojectsArgsForCall)
+}
+
+func (fake *FakeInstance) SetAffectedSubprojectsCalls(stub func(...string)) {
+	fake.setAffectedSubprojectsMutex.Lock()
+	defer fake.setAffectedSubprojectsMutex.Unlock()
+	fake.SetAffectedSubprojectsStub = stub
+}
+
+func (fake *FakeInstance) SetAffectedSubprojectsArgsForCall(i int) []string {
+	fake.setAffectedSubprojectsMutex.RLock()
+	defer fake.setAffectedSubprojectsMutex.RUnlock()
+	argsForCall := fake.setAffectedSubprojectsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInstance) SetParticipatingSIGs(arg1 ...string) {
+	fake.setParticipatingSIGsMutex.Lock()
+	fake.setParticipatingSIGsArgsForCall = append(fake.setParticipatingSIGsArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.SetParticipatingSIGsStub
+	fake.recordInvocation("SetParticipatingSIGs", []interface{}{arg1})
+	fake.setParticipatingSIGsMutex.Unlock()
+	if stub != nil {
+		fake.SetParticipatingSIGsStub(arg1...)
+	}
+}
+
+func (fake *FakeInstance) SetParticipatingSIGsCallCount() int {
+	fake.setParticipatingSIGsMutex.RLock()
+	defer fake.setParticipatingSIGsMutex.RUnlock()
+	return len(fake.setParticipatingSIGsArgsForCall)
+}
+
+func (fake *FakeInstance) SetParticipatingSIGsCalls(stub func(...string)) {
+	fake.setParticipatingSIGsMutex.Lock()
+	defer fake.setParticipatingSIGsMutex.Unlock()
+	fake.SetParticipatingSIGsStub = stub
+}
+
+func (fake *FakeInstance) SetParticipatingSIGsArgsForCall(i int) []string {
+	fake.setParticipatingSIGsMutex.RLock()
+	defer fake.setParticipatingSIGsMutex.RUnlock()
+	argsForCall := fake.setParticipatingSIGsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInstance) SetRouting(arg1 metadata.RoutingInfoProvider) {
+	fake.setRoutingMutex.Lock()
+	fake.setRoutingArgsForCall = append(fake.setRoutingArgsForCall, struct {
+		arg1 metadata.RoutingInfoProvider
+	}{arg1})
+	stub := fake.SetRoutingStub
+	fake.recordInvocation("SetRouting", []interface{}{arg1})
+	fake.setRoutingMutex.Unlock()
+	if stub != nil {
+		fake.SetRoutingStub(arg1)
+	}
+}
+
+func (fake *FakeInstance) SetRoutingCallCount() int {
+	fake.setRoutingMutex.RLock()
+	defer fake.setRoutingMutex.RUnlock()
+	return len(fake.setRoutingArgsForCall)
+}
+
+func (fake *FakeInstance) SetRoutingCalls(stub func(metadata.RoutingInfoProvider)) {
+	fake.setRoutingMutex.Lock()
+	defer fake.setRoutingMutex.Unlock()
+	fake.SetRoutingStub = stub
+}
+
+func (fake *FakeInstance) SetRoutingArgsForCall(i int) metadata.RoutingInfoProvider {
+	fake.setRoutingMutex.RLock()
+	defer fake.setRoutingMutex.RUnlock()
+	argsForCall := fake.setRoutingArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeInstance) SetState(arg1 states.Name) error {
 	fake.setStateMutex.Lock()
 	ret, specificReturn := fake.setStateReturnsOnCall[len(fake.setStateArgsForCall)]
 	fake.setStateArgsForCall = append(fake.setStateArgsForCall, struct {
 		arg1 states.Name
 	}{arg1})
+	stub := fake.SetStateStub
+	fakeReturns := fake.setStateReturns
 	fake.recordInvocation("SetState", []interface{}{arg1})
 	fake.setStateMutex.Unlock()
-	if fake.SetStateStub != nil {
-		return fake.SetStateStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.setStateReturns
 	return fakeReturns.result1
 }
 
@@ -735,15 +1384,16 @@ func (fake *FakeInstance) ShortID() int {
 	ret, specificReturn := fake.shortIDReturnsOnCall[len(fake.shortIDArgsForCall)]
 	fake.shortIDArgsForCall = append(fake.shortIDArgsForCall, struct {
 	}{})
+	stub := fake.ShortIDStub
+	fakeReturns := fake.shortIDReturns
 	fake.recordInvocation("ShortID", []interface{}{})
 	fake.shortIDMutex.Unlock()
-	if fake.ShortIDStub != nil {
-		return fake.ShortIDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.shortIDReturns
 	return fakeReturns.result1
 }
 
@@ -787,15 +1437,16 @@ func (fake *FakeInstance) State() states.Name {
 	ret, specificReturn := fake.stateReturnsOnCall[len(fake.stateArgsForCall)]
 	fake.stateArgsForCall = append(fake.stateArgsForCall, struct {
 	}{})
+	stub := fake.StateStub
+	fakeReturns := fake.stateReturns
 	fake.recordInvocation("State", []interface{}{})
 	fake.stateMutex.Unlock()
-	if fake.StateStub != nil {
-		return fake.StateStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.stateReturns
 	return fakeReturns.result1
 }
 
@@ -839,15 +1490,16 @@ func (fake *FakeInstance) Title() string {
 	ret, specificReturn := fake.titleReturnsOnCall[len(fake.titleArgsForCall)]
 	fake.titleArgsForCall = append(fake.titleArgsForCall, struct {
 	}{})
+	stub := fake.TitleStub
+	fakeReturns := fake.titleReturns
 	fake.recordInvocation("Title", []interface{}{})
 	fake.titleMutex.Unlock()
-	if fake.TitleStub != nil {
-		return fake.TitleStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.titleReturns
 	return fakeReturns.result1
 }
 
@@ -891,15 +1543,16 @@ func (fake *FakeInstance) UniqueID() string {
 	ret, specificReturn := fake.uniqueIDReturnsOnCall[len(fake.uniqueIDArgsForCall)]
 	fake.uniqueIDArgsForCall = append(fake.uniqueIDArgsForCall, struct {
 	}{})
+	stub := fake.UniqueIDStub
+	fakeReturns := fake.uniqueIDReturns
 	fake.recordInvocation("UniqueID", []interface{}{})
 	fake.uniqueIDMutex.Unlock()
-	if fake.UniqueIDStub != nil {
-		return fake.UniqueIDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uniqueIDReturns
 	return fakeReturns.result1
 }
 
@@ -938,6 +1591,59 @@ func (fake *FakeInstance) UniqueIDReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeInstance) Warnings() []check.Finding {
+	fake.warningsMutex.Lock()
+	ret, specificReturn := fake.warningsReturnsOnCall[len(fake.warningsArgsForCall)]
+	fake.warningsArgsForCall = append(fake.warningsArgsForCall, struct {
+	}{})
+	stub := fake.WarningsStub
+	fakeReturns := fake.warningsReturns
+	fake.recordInvocation("Warnings", []interface{}{})
+	fake.warningsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstance) WarningsCallCount() int {
+	fake.warningsMutex.RLock()
+	defer fake.warningsMutex.RUnlock()
+	return len(fake.warningsArgsForCall)
+}
+
+func (fake *FakeInstance) WarningsCalls(stub func() []check.Finding) {
+	fake.warningsMutex.Lock()
+	defer fake.warningsMutex.Unlock()
+	fake.WarningsStub = stub
+}
+
+func (fake *FakeInstance) WarningsReturns(result1 []check.Finding) {
+	fake.warningsMutex.Lock()
+	defer fake.warningsMutex.Unlock()
+	fake.WarningsStub = nil
+	fake.warningsReturns = struct {
+		result1 []check.Finding
+	}{result1}
+}
+
+func (fake *FakeInstance) WarningsReturnsOnCall(i int, result1 []check.Finding) {
+	fake.warningsMutex.Lock()
+	defer fake.warningsMutex.Unlock()
+	fake.WarningsStub = nil
+	if fake.warningsReturnsOnCall == nil {
+		fake.warningsReturnsOnCall = make(map[int]struct {
+			result1 []check.Finding
+		})
+	}
+	fake.warningsReturnsOnCall[i] = struct {
+		result1 []check.Finding
+	}{result1}
+}
+
 func (fake *FakeInstance) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -945,8 +1651,16 @@ func (fake *FakeInstance) Invocations() map[string][][]interface{} {
 	defer fake.addApproversMutex.RUnlock()
 	fake.addChecksMutex.RLock()
 	defer fake.addChecksMutex.RUnlock()
+	fake.addEventMutex.RLock()
+	defer fake.addEventMutex.RUnlock()
+	fake.addPullRequestMutex.RLock()
+	defer fake.addPullRequestMutex.RUnlock()
 	fake.addReviewersMutex.RLock()
 	defer fake.addReviewersMutex.RUnlock()
+	fake.affectedSubprojectsMutex.RLock()
+	defer fake.affectedSubprojectsMutex.RUnlock()
+	fake.approversMutex.RLock()
+	defer fake.approversMutex.RUnlock()
 	fake.authorsMutex.RLock()
 	defer fake.authorsMutex.RUnlock()
 	fake.checkMutex.RLock()
@@ -955,14 +1669,30 @@ func (fake *FakeInstance) Invocations() map[string][][]interface{} {
 	defer fake.contentDirMutex.RUnlock()
 	fake.createdMutex.RLock()
 	defer fake.createdMutex.RUnlock()
+	fake.developmentThemesMutex.RLock()
+	defer fake.developmentThemesMutex.RUnlock()
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
 	fake.lastUpdatedMutex.RLock()
 	defer fake.lastUpdatedMutex.RUnlock()
 	fake.owningSIGMutex.RLock()
 	defer fake.owningSIGMutex.RUnlock()
+	fake.participatingSIGsMutex.RLock()
+	defer fake.participatingSIGsMutex.RUnlock()
 	fake.persistMutex.RLock()
 	defer fake.persistMutex.RUnlock()
+	fake.pullRequestsMutex.RLock()
+	defer fake.pullRequestsMutex.RUnlock()
+	fake.reviewersMutex.RLock()
+	defer fake.reviewersMutex.RUnlock()
 	fake.sectionsMutex.RLock()
 	defer fake.sectionsMutex.RUnlock()
+	fake.setAffectedSubprojectsMutex.RLock()
+	defer fake.setAffectedSubprojectsMutex.RUnlock()
+	fake.setParticipatingSIGsMutex.RLock()
+	defer fake.setParticipatingSIGsMutex.RUnlock()
+	fake.setRoutingMutex.RLock()
+	defer fake.setRoutingMutex.RUnlock()
 	fake.setStateMutex.RLock()
 	defer fake.setStateMutex.RUnlock()
 	fake.shortIDMutex.RLock()
@@ -973,6 +1703,8 @@ func (fake *FakeInstance) Invocations() map[string][][]interface{} {
 	defer fake.titleMutex.RUnlock()
 	fake.uniqueIDMutex.RLock()
 	defer fake.uniqueIDMutex.RUnlock()
+	fake.warningsMutex.RLock()
+	defer fake.warningsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,82 @@
+package metadata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Identity is a person associated with a KEP such as an author, reviewer or
+// approver. People are identified by their GitHub handle, an optional display
+// name is kept when given
+type Identity struct {
+	Handle string // without a leading `@`
+	Name   string
+}
+
+// ParseIdentity parses the forms people are recorded as in KEP metadata:
+// `@handle`, `handle` and `Full Name (@handle)`. A string containing spaces
+// but no parenthesized handle is treated as a display name only
+func ParseIdentity(s string) Identity {
+	s = strings.TrimSpace(s)
+
+	open := strings.LastIndex(s, "(")
+	if open >= 0 && strings.HasSuffix(s, ")") {
+		return Identity{
+			Handle: trimHandle(s[open+1 : len(s)-1]),
+			Name:   strings.TrimSpace(s[:open]),
+		}
+	}
+
+	if strings.ContainsAny(s, " \t") {
+		return Identity{Name: s}
+	}
+
+	return Identity{Handle: trimHandle(s)}
+}
+
+// Key is the canonical form used to compare identities: the lower cased
+// GitHub handle, or the lower cased display name when there is no handle
+func (i Identity) Key() string {
+	if i.Handle != "" {
+		return strings.ToLower(i.Handle)
+	}
+
+	return strings.ToLower(i.Name)
+}
+
+// String formats the identity as `Full Name (@handle)` or `handle`
+func (i Identity) String() string {
+	switch {
+	case i.Handle == "":
+		return i.Name
+	case i.Name == "":
+		return i.Handle
+	}
+
+	return i.Name + " (@" + i.Handle + ")"
+}
+
+// IdentityKey returns the canonical form of an identity as recorded in KEP
+// metadata, see Identity.Key
+func IdentityKey(s string) string {
+	return ParseIdentity(s).Key()
+}
+
+// SameIdentity returns whether a and b refer to the same person
+func SameIdentity(a string, b string) bool {
+	return IdentityKey(a) == IdentityKey(b)
+}
+
+// IsHandle returns whether s is a GitHub handle, without a leading `@`
+func IsHandle(s string) bool {
+	return len(s) <= maxHandleLength && handleFormat.MatchString(s)
+}
+
+// GitHub handles are alphanumeric, may contain single hyphens and are at most 39 characters
+var handleFormat = regexp.MustCompile(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`)
+
+const maxHandleLength = 39
+
+func trimHandle(s string) string {
+	return strings.TrimPrefix(strings.TrimSpace(s), "@")
+}
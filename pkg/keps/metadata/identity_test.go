@@ -0,0 +1,34 @@
+package metadata_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+)
+
+var _ = Describe("Identity", func() {
+	Describe("ParseIdentity()", func() {
+		It("parses each form people are recorded as", func() {
+			Expect(metadata.ParseIdentity("@dchen1107")).To(Equal(metadata.Identity{Handle: "dchen1107"}))
+			Expect(metadata.ParseIdentity(" dchen1107 ")).To(Equal(metadata.Identity{Handle: "dchen1107"}))
+			Expect(metadata.ParseIdentity("Dawn Chen (@dchen1107)")).To(Equal(metadata.Identity{Handle: "dchen1107", Name: "Dawn Chen"}))
+			Expect(metadata.ParseIdentity("Dawn Chen (dchen1107)")).To(Equal(metadata.Identity{Handle: "dchen1107", Name: "Dawn Chen"}))
+			Expect(metadata.ParseIdentity("Dawn Chen")).To(Equal(metadata.Identity{Name: "Dawn Chen"}))
+		})
+
+		It("formats identities back into a recognized form", func() {
+			for _, given := range []string{"dchen1107", "Dawn Chen (@dchen1107)", "Dawn Chen"} {
+				Expect(metadata.ParseIdentity(given).String()).To(Equal(given))
+			}
+		})
+	})
+
+	Describe("SameIdentity()", func() {
+		It("compares GitHub handles ignoring case and form", func() {
+			Expect(metadata.SameIdentity("@DChen1107", "Dawn Chen (@dchen1107)")).To(BeTrue())
+			Expect(metadata.SameIdentity("dchen1107", "derekwaynecarr")).To(BeFalse())
+			Expect(metadata.SameIdentity("Dawn Chen", "dawn chen")).To(BeTrue())
+		})
+	})
+})
@@ -27,6 +27,8 @@ type KEP interface {
 	State() states.Name
 	DevelopmentThemes() []string
 	SectionLocations() []string // really are section paths
+	PullRequests() []PullRequest
+	Events() []Event
 
 	// should be (string) references to other KEPs
 	Replaces() []string
@@ -48,13 +50,19 @@ type KEP interface {
 	AddSectionLocations([]string)
 	AddApprovers([]string)
 	AddReviewers([]string)
+	SetParticipatingSIGs([]string)
+	SetAffectedSubprojects([]string)
+	SetRouting(RoutingInfoProvider) // replaces all routing info, e.g. when a KEP moves
+	AddPullRequest(PullRequest)     // replaces any pull request with the same URL
+	AddEvent(description string)
 	Persist() error
 
 	// External locking support
 	sync.Locker
 }
 
-type routingInfoProvider interface {
+// RoutingInfoProvider describes who owns a KEP and where it lives, see sigs.RoutingInfo
+type RoutingInfoProvider interface {
 	OwningSIG() string
 	AffectedSubprojects() []string
 	ParticipatingSIGs() []string
@@ -63,8 +71,9 @@ type routingInfoProvider interface {
 	ContentDir() string
 }
 
-func New(authors []string, title string, routingInfo routingInfoProvider) (KEP, error) {
+func New(authors []string, title string, routingInfo RoutingInfoProvider) (KEP, error) {
 	k := &kep{
+		SchemaVersionField:       SchemaVersion,
 		AuthorsField:             authors,
 		TitleField:               title,
 		OwningSIGField:           routingInfo.OwningSIG(),
@@ -77,8 +86,8 @@ func New(authors []string, title string, routingInfo routingInfoProvider) (KEP,
 		UniqueIDField:            uuid.New().String(), // note: will panic on error
 		StateField:               states.Draft,
 		contentDir:               routingInfo.ContentDir(),
-		inApproversSet:           make(map[string]bool),
-		inReviewersSet:           make(map[string]bool),
+		inApproversSet:           make(map[string]string),
+		inReviewersSet:           make(map[string]string),
 		inSectionLocationsSet:    make(map[string]bool),
 		RWMutex:                  new(sync.RWMutex),
 	}
@@ -115,6 +124,7 @@ func (s *kepSection) Name() string     { return s.NameField }
 func (s *kepSection) Filename() string { return s.FilenameField }
 
 type kep struct {
+	SchemaVersionField     int         `yaml:"schema_version"`
 	AuthorsField           []string    `yaml:"authors,omitempty"`
 	TitleField             string      `yaml:"title,omitempty"`
 	ShortIDField           *int        `yaml:"kep_number,omitempty"`
@@ -130,16 +140,19 @@ type kep struct {
 	UniqueIDField          string      `yaml:"uuid,omitempty"`
 	SectionLocationsField  []string    `yaml:"sections,omitempty"`
 
+	PullRequestsField []PullRequest `yaml:"pull_requests,omitempty"`
+	EventsField       []Event       `yaml:"events,omitempty"`
+
 	OwningSIGField           string   `yaml:"owning_sig,omitempty"`
 	AffectedSubprojectsField []string `yaml:"affected_subprojects,omitempty"`
 	ParticipatingSIGsField   []string `yaml:"participating_sigs,omitempty"`
 	KubernetesWideField      bool     `yaml:"kubernetes_wide,omitempty"`
 	SIGWideField             bool     `yaml:"sig_wide,omitempty"`
 
-	inApproversSet        map[string]bool `yaml:"-"` // do not persist this
-	inReviewersSet        map[string]bool `yaml:"-"` // do not persist this
-	inSectionLocationsSet map[string]bool `yaml:"-"` // do not persist this
-	contentDir            string          `yaml:"-"` // do not persist this
+	inApproversSet        map[string]string `yaml:"-"` // do not persist this, keyed by IdentityKey
+	inReviewersSet        map[string]string `yaml:"-"` // do not persist this, keyed by IdentityKey
+	inSectionLocationsSet map[string]bool   `yaml:"-"` // do not persist this
+	contentDir            string            `yaml:"-"` // do not persist this
 
 	*sync.RWMutex `yaml:"-"` // do not persist this
 }
@@ -159,13 +172,13 @@ func (k *kep) Persist() error {
 	}
 
 	k.ApproversField = []string{}
-	for approver := range k.inApproversSet {
+	for _, approver := range k.inApproversSet {
 		k.ApproversField = append(k.ApproversField, approver)
 
 	}
 
 	k.ReviewersField = []string{}
-	for reviewer := range k.inReviewersSet {
+	for _, reviewer := range k.inReviewersSet {
 		k.ReviewersField = append(k.ReviewersField, reviewer)
 
 	}
@@ -232,23 +245,30 @@ func (k *kep) State() states.Name {
 	return k.StateField
 }
 
-// owners
+// owners, deduplicated by identity keeping the form first given
 
 func (k *kep) AddApprovers(approvers []string) {
 	k.Lock()
 	defer k.Unlock()
 
-	for _, approver := range approvers {
-		k.inApproversSet[approver] = true
-	}
+	addIdentities(k.inApproversSet, approvers)
 }
 
 func (k *kep) AddReviewers(reviewers []string) {
 	k.Lock()
 	defer k.Unlock()
 
-	for _, reviewer := range reviewers {
-		k.inReviewersSet[reviewer] = true
+	addIdentities(k.inReviewersSet, reviewers)
+}
+
+func addIdentities(set map[string]string, identities []string) {
+	for _, identity := range identities {
+		key := IdentityKey(identity)
+		if _, ok := set[key]; ok {
+			continue
+		}
+
+		set[key] = identity
 	}
 }
 
@@ -264,7 +284,7 @@ func (k *kep) Reviewers() []string {
 	defer k.RUnlock()
 
 	reviewers := []string{}
-	for reviewer := range k.inReviewersSet {
+	for _, reviewer := range k.inReviewersSet {
 		reviewers = append(reviewers, reviewer)
 	}
 
@@ -276,7 +296,7 @@ func (k *kep) Approvers() []string {
 	defer k.RUnlock()
 
 	approvers := []string{}
-	for approver := range k.inApproversSet {
+	for _, approver := range k.inApproversSet {
 		approvers = append(approvers, approver)
 	}
 
@@ -379,6 +399,32 @@ func (k *kep) AffectedSubprojects() []string {
 	return k.AffectedSubprojectsField
 }
 
+func (k *kep) SetRouting(routingInfo RoutingInfoProvider) {
+	k.Lock()
+	defer k.Unlock()
+
+	k.OwningSIGField = routingInfo.OwningSIG()
+	k.AffectedSubprojectsField = routingInfo.AffectedSubprojects()
+	k.ParticipatingSIGsField = routingInfo.ParticipatingSIGs()
+	k.KubernetesWideField = routingInfo.KubernetesWide()
+	k.SIGWideField = routingInfo.SIGWide()
+	k.contentDir = routingInfo.ContentDir()
+}
+
+func (k *kep) SetAffectedSubprojects(subprojects []string) {
+	k.Lock()
+	defer k.Unlock()
+
+	k.AffectedSubprojectsField = subprojects
+}
+
+func (k *kep) SetParticipatingSIGs(sigs []string) {
+	k.Lock()
+	defer k.Unlock()
+
+	k.ParticipatingSIGsField = sigs
+}
+
 func (k *kep) ParticipatingSIGs() []string {
 	k.RLock()
 	defer k.RUnlock()
@@ -409,15 +455,21 @@ func sectionKey(s string) string {
 	return strings.ToLower(strings.TrimSpace(s))
 }
 
+// fromBytes reads metadata of any supported schema version, see Migrations
 func fromBytes(b []byte) (*kep, error) {
+	b, _, err := Migrate(b)
+	if err != nil {
+		return nil, err
+	}
+
 	k := &kep{
 		inSectionLocationsSet: make(map[string]bool),
-		inApproversSet:        make(map[string]bool),
-		inReviewersSet:        make(map[string]bool),
+		inApproversSet:        make(map[string]string),
+		inReviewersSet:        make(map[string]string),
 		RWMutex:               new(sync.RWMutex),
 	}
 
-	err := yaml.Unmarshal(b, k)
+	err = yaml.Unmarshal(b, k)
 	if err != nil {
 		return nil, err
 	}
@@ -426,13 +478,8 @@ func fromBytes(b []byte) (*kep, error) {
 		k.inSectionLocationsSet[p] = true
 	}
 
-	for _, a := range k.ApproversField {
-		k.inApproversSet[a] = true
-	}
-
-	for _, r := range k.ReviewersField {
-		k.inReviewersSet[r] = true
-	}
+	addIdentities(k.inApproversSet, k.ApproversField)
+	addIdentities(k.inReviewersSet, k.ReviewersField)
 
 	return k, nil
 }
@@ -82,6 +82,10 @@ var _ = Describe("KEP Metdata", func() {
 
 			m.AddApprovers([]string{"bgrant0607", "bgrant0607"})
 			Expect(m.Approvers()).To(HaveLen(1))
+
+			By("treating other forms of the same GitHub handle as duplicates")
+			m.AddApprovers([]string{"@BGrant0607", "Brian Grant (@bgrant0607)"})
+			Expect(m.Approvers()).To(Equal([]string{"bgrant0607"}))
 		})
 	})
 
@@ -105,6 +109,10 @@ var _ = Describe("KEP Metdata", func() {
 
 			m.AddReviewers([]string{"smarterclayton", "smarterclayton"})
 			Expect(m.Reviewers()).To(HaveLen(1))
+
+			By("treating other forms of the same GitHub handle as duplicates")
+			m.AddReviewers([]string{"@SmarterClayton", "Clayton Coleman (@smarterclayton)"})
+			Expect(m.Reviewers()).To(Equal([]string{"smarterclayton"}))
 		})
 	})
 
@@ -178,4 +186,47 @@ var _ = Describe("KEP Metdata", func() {
 			Expect(lastUpdatedMinute.Equal(nowish)).To(BeTrue())
 		})
 	})
+
+	Describe("#AddPullRequest()", func() {
+		It("records pull requests, replacing any with the same URL, and persists them with events", func() {
+			tmpDir, err := ioutil.TempDir("", "kep-content")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpDir)
+
+			info := newMockRoutingInfoProvider()
+			info.OwningSIGOutput.Ret0 <- "sig-node"
+			info.AffectedSubprojectsOutput.Ret0 <- []string{"kubelet"}
+			info.SIGWideOutput.Ret0 <- true
+			info.KubernetesWideOutput.Ret0 <- false
+			info.ParticipatingSIGsOutput.Ret0 <- []string{}
+			info.ContentDirOutput.Ret0 <- tmpDir
+
+			m, err := metadata.New([]string{"dchen1107"}, "kubelet", info)
+			Expect(err).ToNot(HaveOccurred())
+
+			pr := metadata.PullRequest{
+				URL:     "https://github.com/kubernetes/enhancements/pull/1",
+				Number:  1,
+				Purpose: metadata.PurposePropose,
+				State:   metadata.PullRequestOpen,
+			}
+
+			m.AddPullRequest(pr)
+
+			pr.State = metadata.PullRequestMerged
+			m.AddPullRequest(pr)
+			m.AddEvent("propose pull request #1 merged")
+
+			Expect(m.PullRequests()).To(Equal([]metadata.PullRequest{pr}))
+
+			Expect(m.Persist()).To(Succeed())
+
+			readMetadata, err := metadata.Open(tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readMetadata.PullRequests()).To(Equal([]metadata.PullRequest{pr}))
+			Expect(readMetadata.Events()).To(HaveLen(1))
+			Expect(readMetadata.Events()[0].Description).To(Equal("propose pull request #1 merged"))
+		})
+	})
 })
@@ -2,11 +2,11 @@
 package metadatafakes
 
 import (
-	sync "sync"
-	time "time"
+	"sync"
+	"time"
 
-	metadata "github.com/calebamiles/keps/pkg/keps/metadata"
-	states "github.com/calebamiles/keps/pkg/keps/states"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/states"
 )
 
 type FakeKEP struct {
@@ -15,6 +15,16 @@ type FakeKEP struct {
 	addApproversArgsForCall []struct {
 		arg1 []string
 	}
+	AddEventStub        func(string)
+	addEventMutex       sync.RWMutex
+	addEventArgsForCall []struct {
+		arg1 string
+	}
+	AddPullRequestStub        func(metadata.PullRequest)
+	addPullRequestMutex       sync.RWMutex
+	addPullRequestArgsForCall []struct {
+		arg1 metadata.PullRequest
+	}
 	AddReviewersStub        func([]string)
 	addReviewersMutex       sync.RWMutex
 	addReviewersArgsForCall []struct {
@@ -95,6 +105,16 @@ type FakeKEP struct {
 	editorsReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	EventsStub        func() []metadata.Event
+	eventsMutex       sync.RWMutex
+	eventsArgsForCall []struct {
+	}
+	eventsReturns struct {
+		result1 []metadata.Event
+	}
+	eventsReturnsOnCall map[int]struct {
+		result1 []metadata.Event
+	}
 	KubernetesWideStub        func() bool
 	kubernetesWideMutex       sync.RWMutex
 	kubernetesWideArgsForCall []struct {
@@ -149,6 +169,16 @@ type FakeKEP struct {
 	persistReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PullRequestsStub        func() []metadata.PullRequest
+	pullRequestsMutex       sync.RWMutex
+	pullRequestsArgsForCall []struct {
+	}
+	pullRequestsReturns struct {
+		result1 []metadata.PullRequest
+	}
+	pullRequestsReturnsOnCall map[int]struct {
+		result1 []metadata.PullRequest
+	}
 	ReplacesStub        func() []string
 	replacesMutex       sync.RWMutex
 	replacesArgsForCall []struct {
@@ -189,6 +219,21 @@ type FakeKEP struct {
 	sectionLocationsReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	SetAffectedSubprojectsStub        func([]string)
+	setAffectedSubprojectsMutex       sync.RWMutex
+	setAffectedSubprojectsArgsForCall []struct {
+		arg1 []string
+	}
+	SetParticipatingSIGsStub        func([]string)
+	setParticipatingSIGsMutex       sync.RWMutex
+	setParticipatingSIGsArgsForCall []struct {
+		arg1 []string
+	}
+	SetRoutingStub        func(metadata.RoutingInfoProvider)
+	setRoutingMutex       sync.RWMutex
+	setRoutingArgsForCall []struct {
+		arg1 metadata.RoutingInfoProvider
+	}
 	SetStateStub        func(states.Name)
 	setStateMutex       sync.RWMutex
 	setStateArgsForCall []struct {
@@ -248,11 +293,6 @@ type FakeKEP struct {
 	unlockMutex       sync.RWMutex
 	unlockArgsForCall []struct {
 	}
-	UnsafeAddSectionLocationsStub        func([]string)
-	unsafeAddSectionLocationsMutex       sync.RWMutex
-	unsafeAddSectionLocationsArgsForCall []struct {
-		arg1 []string
-	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -267,9 +307,10 @@ func (fake *FakeKEP) AddApprovers(arg1 []string) {
 	fake.addApproversArgsForCall = append(fake.addApproversArgsForCall, struct {
 		arg1 []string
 	}{arg1Copy})
+	stub := fake.AddApproversStub
 	fake.recordInvocation("AddApprovers", []interface{}{arg1Copy})
 	fake.addApproversMutex.Unlock()
-	if fake.AddApproversStub != nil {
+	if stub != nil {
 		fake.AddApproversStub(arg1)
 	}
 }
@@ -293,6 +334,70 @@ func (fake *FakeKEP) AddApproversArgsForCall(i int) []string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeKEP) AddEvent(arg1 string) {
+	fake.addEventMutex.Lock()
+	fake.addEventArgsForCall = append(fake.addEventArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.AddEventStub
+	fake.recordInvocation("AddEvent", []interface{}{arg1})
+	fake.addEventMutex.Unlock()
+	if stub != nil {
+		fake.AddEventStub(arg1)
+	}
+}
+
+func (fake *FakeKEP) AddEventCallCount() int {
+	fake.addEventMutex.RLock()
+	defer fake.addEventMutex.RUnlock()
+	return len(fake.addEventArgsForCall)
+}
+
+func (fake *FakeKEP) AddEventCalls(stub func(string)) {
+	fake.addEventMutex.Lock()
+	defer fake.addEventMutex.Unlock()
+	fake.AddEventStub = stub
+}
+
+func (fake *FakeKEP) AddEventArgsForCall(i int) string {
+	fake.addEventMutex.RLock()
+	defer fake.addEventMutex.RUnlock()
+	argsForCall := fake.addEventArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKEP) AddPullRequest(arg1 metadata.PullRequest) {
+	fake.addPullRequestMutex.Lock()
+	fake.addPullRequestArgsForCall = append(fake.addPullRequestArgsForCall, struct {
+		arg1 metadata.PullRequest
+	}{arg1})
+	stub := fake.AddPullRequestStub
+	fake.recordInvocation("AddPullRequest", []interface{}{arg1})
+	fake.addPullRequestMutex.Unlock()
+	if stub != nil {
+		fake.AddPullRequestStub(arg1)
+	}
+}
+
+func (fake *FakeKEP) AddPullRequestCallCount() int {
+	fake.addPullRequestMutex.RLock()
+	defer fake.addPullRequestMutex.RUnlock()
+	return len(fake.addPullRequestArgsForCall)
+}
+
+func (fake *FakeKEP) AddPullRequestCalls(stub func(metadata.PullRequest)) {
+	fake.addPullRequestMutex.Lock()
+	defer fake.addPullRequestMutex.Unlock()
+	fake.AddPullRequestStub = stub
+}
+
+func (fake *FakeKEP) AddPullRequestArgsForCall(i int) metadata.PullRequest {
+	fake.addPullRequestMutex.RLock()
+	defer fake.addPullRequestMutex.RUnlock()
+	argsForCall := fake.addPullRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeKEP) AddReviewers(arg1 []string) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -303,9 +408,10 @@ func (fake *FakeKEP) AddReviewers(arg1 []string) {
 	fake.addReviewersArgsForCall = append(fake.addReviewersArgsForCall, struct {
 		arg1 []string
 	}{arg1Copy})
+	stub := fake.AddReviewersStub
 	fake.recordInvocation("AddReviewers", []interface{}{arg1Copy})
 	fake.addReviewersMutex.Unlock()
-	if fake.AddReviewersStub != nil {
+	if stub != nil {
 		fake.AddReviewersStub(arg1)
 	}
 }
@@ -339,9 +445,10 @@ func (fake *FakeKEP) AddSectionLocations(arg1 []string) {
 	fake.addSectionLocationsArgsForCall = append(fake.addSectionLocationsArgsForCall, struct {
 		arg1 []string
 	}{arg1Copy})
+	stub := fake.AddSectionLocationsStub
 	fake.recordInvocation("AddSectionLocations", []interface{}{arg1Copy})
 	fake.addSectionLocationsMutex.Unlock()
-	if fake.AddSectionLocationsStub != nil {
+	if stub != nil {
 		fake.AddSectionLocationsStub(arg1)
 	}
 }
@@ -370,15 +477,16 @@ func (fake *FakeKEP) AffectedSubprojects() []string {
 	ret, specificReturn := fake.affectedSubprojectsReturnsOnCall[len(fake.affectedSubprojectsArgsForCall)]
 	fake.affectedSubprojectsArgsForCall = append(fake.affectedSubprojectsArgsForCall, struct {
 	}{})
+	stub := fake.AffectedSubprojectsStub
+	fakeReturns := fake.affectedSubprojectsReturns
 	fake.recordInvocation("AffectedSubprojects", []interface{}{})
 	fake.affectedSubprojectsMutex.Unlock()
-	if fake.AffectedSubprojectsStub != nil {
-		return fake.AffectedSubprojectsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.affectedSubprojectsReturns
 	return fakeReturns.result1
 }
 
@@ -422,15 +530,16 @@ func (fake *FakeKEP) Approvers() []string {
 	ret, specificReturn := fake.approversReturnsOnCall[len(fake.approversArgsForCall)]
 	fake.approversArgsForCall = append(fake.approversArgsForCall, struct {
 	}{})
+	stub := fake.ApproversStub
+	fakeReturns := fake.approversReturns
 	fake.recordInvocation("Approvers", []interface{}{})
 	fake.approversMutex.Unlock()
-	if fake.ApproversStub != nil {
-		return fake.ApproversStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.approversReturns
 	return fakeReturns.result1
 }
 
@@ -474,15 +583,16 @@ func (fake *FakeKEP) Authors() []string {
 	ret, specificReturn := fake.authorsReturnsOnCall[len(fake.authorsArgsForCall)]
 	fake.authorsArgsForCall = append(fake.authorsArgsForCall, struct {
 	}{})
+	stub := fake.AuthorsStub
+	fakeReturns := fake.authorsReturns
 	fake.recordInvocation("Authors", []interface{}{})
 	fake.authorsMutex.Unlock()
-	if fake.AuthorsStub != nil {
-		return fake.AuthorsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.authorsReturns
 	return fakeReturns.result1
 }
 
@@ -526,15 +636,16 @@ func (fake *FakeKEP) ContentDir() string {
 	ret, specificReturn := fake.contentDirReturnsOnCall[len(fake.contentDirArgsForCall)]
 	fake.contentDirArgsForCall = append(fake.contentDirArgsForCall, struct {
 	}{})
+	stub := fake.ContentDirStub
+	fakeReturns := fake.contentDirReturns
 	fake.recordInvocation("ContentDir", []interface{}{})
 	fake.contentDirMutex.Unlock()
-	if fake.ContentDirStub != nil {
-		return fake.ContentDirStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.contentDirReturns
 	return fakeReturns.result1
 }
 
@@ -578,15 +689,16 @@ func (fake *FakeKEP) Created() time.Time {
 	ret, specificReturn := fake.createdReturnsOnCall[len(fake.createdArgsForCall)]
 	fake.createdArgsForCall = append(fake.createdArgsForCall, struct {
 	}{})
+	stub := fake.CreatedStub
+	fakeReturns := fake.createdReturns
 	fake.recordInvocation("Created", []interface{}{})
 	fake.createdMutex.Unlock()
-	if fake.CreatedStub != nil {
-		return fake.CreatedStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.createdReturns
 	return fakeReturns.result1
 }
 
@@ -630,15 +742,16 @@ func (fake *FakeKEP) DevelopmentThemes() []string {
 	ret, specificReturn := fake.developmentThemesReturnsOnCall[len(fake.developmentThemesArgsForCall)]
 	fake.developmentThemesArgsForCall = append(fake.developmentThemesArgsForCall, struct {
 	}{})
+	stub := fake.DevelopmentThemesStub
+	fakeReturns := fake.developmentThemesReturns
 	fake.recordInvocation("DevelopmentThemes", []interface{}{})
 	fake.developmentThemesMutex.Unlock()
-	if fake.DevelopmentThemesStub != nil {
-		return fake.DevelopmentThemesStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.developmentThemesReturns
 	return fakeReturns.result1
 }
 
@@ -682,15 +795,16 @@ func (fake *FakeKEP) Editors() []string {
 	ret, specificReturn := fake.editorsReturnsOnCall[len(fake.editorsArgsForCall)]
 	fake.editorsArgsForCall = append(fake.editorsArgsForCall, struct {
 	}{})
+	stub := fake.EditorsStub
+	fakeReturns := fake.editorsReturns
 	fake.recordInvocation("Editors", []interface{}{})
 	fake.editorsMutex.Unlock()
-	if fake.EditorsStub != nil {
-		return fake.EditorsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.editorsReturns
 	return fakeReturns.result1
 }
 
@@ -729,20 +843,74 @@ func (fake *FakeKEP) EditorsReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeKEP) Events() []metadata.Event {
+	fake.eventsMutex.Lock()
+	ret, specificReturn := fake.eventsReturnsOnCall[len(fake.eventsArgsForCall)]
+	fake.eventsArgsForCall = append(fake.eventsArgsForCall, struct {
+	}{})
+	stub := fake.EventsStub
+	fakeReturns := fake.eventsReturns
+	fake.recordInvocation("Events", []interface{}{})
+	fake.eventsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeKEP) EventsCallCount() int {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	return len(fake.eventsArgsForCall)
+}
+
+func (fake *FakeKEP) EventsCalls(stub func() []metadata.Event) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = stub
+}
+
+func (fake *FakeKEP) EventsReturns(result1 []metadata.Event) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	fake.eventsReturns = struct {
+		result1 []metadata.Event
+	}{result1}
+}
+
+func (fake *FakeKEP) EventsReturnsOnCall(i int, result1 []metadata.Event) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	if fake.eventsReturnsOnCall == nil {
+		fake.eventsReturnsOnCall = make(map[int]struct {
+			result1 []metadata.Event
+		})
+	}
+	fake.eventsReturnsOnCall[i] = struct {
+		result1 []metadata.Event
+	}{result1}
+}
+
 func (fake *FakeKEP) KubernetesWide() bool {
 	fake.kubernetesWideMutex.Lock()
 	ret, specificReturn := fake.kubernetesWideReturnsOnCall[len(fake.kubernetesWideArgsForCall)]
 	fake.kubernetesWideArgsForCall = append(fake.kubernetesWideArgsForCall, struct {
 	}{})
+	stub := fake.KubernetesWideStub
+	fakeReturns := fake.kubernetesWideReturns
 	fake.recordInvocation("KubernetesWide", []interface{}{})
 	fake.kubernetesWideMutex.Unlock()
-	if fake.KubernetesWideStub != nil {
-		return fake.KubernetesWideStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.kubernetesWideReturns
 	return fakeReturns.result1
 }
 
@@ -786,15 +954,16 @@ func (fake *FakeKEP) LastUpdated() time.Time {
 	ret, specificReturn := fake.lastUpdatedReturnsOnCall[len(fake.lastUpdatedArgsForCall)]
 	fake.lastUpdatedArgsForCall = append(fake.lastUpdatedArgsForCall, struct {
 	}{})
+	stub := fake.LastUpdatedStub
+	fakeReturns := fake.lastUpdatedReturns
 	fake.recordInvocation("LastUpdated", []interface{}{})
 	fake.lastUpdatedMutex.Unlock()
-	if fake.LastUpdatedStub != nil {
-		return fake.LastUpdatedStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.lastUpdatedReturns
 	return fakeReturns.result1
 }
 
@@ -837,9 +1006,10 @@ func (fake *FakeKEP) Lock() {
 	fake.lockMutex.Lock()
 	fake.lockArgsForCall = append(fake.lockArgsForCall, struct {
 	}{})
+	stub := fake.LockStub
 	fake.recordInvocation("Lock", []interface{}{})
 	fake.lockMutex.Unlock()
-	if fake.LockStub != nil {
+	if stub != nil {
 		fake.LockStub()
 	}
 }
@@ -861,15 +1031,16 @@ func (fake *FakeKEP) OwningSIG() string {
 	ret, specificReturn := fake.owningSIGReturnsOnCall[len(fake.owningSIGArgsForCall)]
 	fake.owningSIGArgsForCall = append(fake.owningSIGArgsForCall, struct {
 	}{})
+	stub := fake.OwningSIGStub
+	fakeReturns := fake.owningSIGReturns
 	fake.recordInvocation("OwningSIG", []interface{}{})
 	fake.owningSIGMutex.Unlock()
-	if fake.OwningSIGStub != nil {
-		return fake.OwningSIGStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.owningSIGReturns
 	return fakeReturns.result1
 }
 
@@ -913,15 +1084,16 @@ func (fake *FakeKEP) ParticipatingSIGs() []string {
 	ret, specificReturn := fake.participatingSIGsReturnsOnCall[len(fake.participatingSIGsArgsForCall)]
 	fake.participatingSIGsArgsForCall = append(fake.participatingSIGsArgsForCall, struct {
 	}{})
+	stub := fake.ParticipatingSIGsStub
+	fakeReturns := fake.participatingSIGsReturns
 	fake.recordInvocation("ParticipatingSIGs", []interface{}{})
 	fake.participatingSIGsMutex.Unlock()
-	if fake.ParticipatingSIGsStub != nil {
-		return fake.ParticipatingSIGsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.participatingSIGsReturns
 	return fakeReturns.result1
 }
 
@@ -965,15 +1137,16 @@ func (fake *FakeKEP) Persist() error {
 	ret, specificReturn := fake.persistReturnsOnCall[len(fake.persistArgsForCall)]
 	fake.persistArgsForCall = append(fake.persistArgsForCall, struct {
 	}{})
+	stub := fake.PersistStub
+	fakeReturns := fake.persistReturns
 	fake.recordInvocation("Persist", []interface{}{})
 	fake.persistMutex.Unlock()
-	if fake.PersistStub != nil {
-		return fake.PersistStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.persistReturns
 	return fakeReturns.result1
 }
 
@@ -1012,20 +1185,74 @@ func (fake *FakeKEP) PersistReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeKEP) PullRequests() []metadata.PullRequest {
+	fake.pullRequestsMutex.Lock()
+	ret, specificReturn := fake.pullRequestsReturnsOnCall[len(fake.pullRequestsArgsForCall)]
+	fake.pullRequestsArgsForCall = append(fake.pullRequestsArgsForCall, struct {
+	}{})
+	stub := fake.PullRequestsStub
+	fakeReturns := fake.pullRequestsReturns
+	fake.recordInvocation("PullRequests", []interface{}{})
+	fake.pullRequestsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeKEP) PullRequestsCallCount() int {
+	fake.pullRequestsMutex.RLock()
+	defer fake.pullRequestsMutex.RUnlock()
+	return len(fake.pullRequestsArgsForCall)
+}
+
+func (fake *FakeKEP) PullRequestsCalls(stub func() []metadata.PullRequest) {
+	fake.pullRequestsMutex.Lock()
+	defer fake.pullRequestsMutex.Unlock()
+	fake.PullRequestsStub = stub
+}
+
+func (fake *FakeKEP) PullRequestsReturns(result1 []metadata.PullRequest) {
+	fake.pullRequestsMutex.Lock()
+	defer fake.pullRequestsMutex.Unlock()
+	fake.PullRequestsStub = nil
+	fake.pullRequestsReturns = struct {
+		result1 []metadata.PullRequest
+	}{result1}
+}
+
+func (fake *FakeKEP) PullRequestsReturnsOnCall(i int, result1 []metadata.PullRequest) {
+	fake.pullRequestsMutex.Lock()
+	defer fake.pullRequestsMutex.Unlock()
+	fake.PullRequestsStub = nil
+	if fake.pullRequestsReturnsOnCall == nil {
+		fake.pullRequestsReturnsOnCall = make(map[int]struct {
+			result1 []metadata.PullRequest
+		})
+	}
+	fake.pullRequestsReturnsOnCall[i] = struct {
+		result1 []metadata.PullRequest
+	}{result1}
+}
+
 func (fake *FakeKEP) Replaces() []string {
 	fake.replacesMutex.Lock()
 	ret, specificReturn := fake.replacesReturnsOnCall[len(fake.replacesArgsForCall)]
 	fake.replacesArgsForCall = append(fake.replacesArgsForCall, struct {
 	}{})
+	stub := fake.ReplacesStub
+	fakeReturns := fake.replacesReturns
 	fake.recordInvocation("Replaces", []interface{}{})
 	fake.replacesMutex.Unlock()
-	if fake.ReplacesStub != nil {
-		return fake.ReplacesStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.replacesReturns
 	return fakeReturns.result1
 }
 
@@ -1069,15 +1296,16 @@ func (fake *FakeKEP) Reviewers() []string {
 	ret, specificReturn := fake.reviewersReturnsOnCall[len(fake.reviewersArgsForCall)]
 	fake.reviewersArgsForCall = append(fake.reviewersArgsForCall, struct {
 	}{})
+	stub := fake.ReviewersStub
+	fakeReturns := fake.reviewersReturns
 	fake.recordInvocation("Reviewers", []interface{}{})
 	fake.reviewersMutex.Unlock()
-	if fake.ReviewersStub != nil {
-		return fake.ReviewersStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.reviewersReturns
 	return fakeReturns.result1
 }
 
@@ -1121,15 +1349,16 @@ func (fake *FakeKEP) SIGWide() bool {
 	ret, specificReturn := fake.sIGWideReturnsOnCall[len(fake.sIGWideArgsForCall)]
 	fake.sIGWideArgsForCall = append(fake.sIGWideArgsForCall, struct {
 	}{})
+	stub := fake.SIGWideStub
+	fakeReturns := fake.sIGWideReturns
 	fake.recordInvocation("SIGWide", []interface{}{})
 	fake.sIGWideMutex.Unlock()
-	if fake.SIGWideStub != nil {
-		return fake.SIGWideStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.sIGWideReturns
 	return fakeReturns.result1
 }
 
@@ -1173,15 +1402,16 @@ func (fake *FakeKEP) SectionLocations() []string {
 	ret, specificReturn := fake.sectionLocationsReturnsOnCall[len(fake.sectionLocationsArgsForCall)]
 	fake.sectionLocationsArgsForCall = append(fake.sectionLocationsArgsForCall, struct {
 	}{})
+	stub := fake.SectionLocationsStub
+	fakeReturns := fake.sectionLocationsReturns
 	fake.recordInvocation("SectionLocations", []interface{}{})
 	fake.sectionLocationsMutex.Unlock()
-	if fake.SectionLocationsStub != nil {
-		return fake.SectionLocationsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.sectionLocationsReturns
 	return fakeReturns.result1
 }
 
@@ -1220,14 +1450,121 @@ func (fake *FakeKEP) SectionLocationsReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeKEP) SetAffectedSubprojects(arg1 []string) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.setAffectedSubprojectsMutex.Lock()
+	fake.setAffectedSubprojectsArgsForCall = append(fake.setAffectedSubprojectsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.SetAffectedSubprojectsStub
+	fake.recordInvocation("SetAffectedSubprojects", []interface{}{arg1Copy})
+	fake.setAffectedSubprojectsMutex.Unlock()
+	if stub != nil {
+		fake.SetAffectedSubprojectsStub(arg1)
+	}
+}
+
+func (fake *FakeKEP) SetAffectedSubprojectsCallCount() int {
+	fake.setAffectedSubprojectsMutex.RLock()
+	defer fake.setAffectedSubprojectsMutex.RUnlock()
+	return len(fake.setAffectedSubprojectsArgsForCall)
+}
+
+func (fake *FakeKEP) SetAffectedSubprojectsCalls(stub func([]string)) {
+	fake.setAffectedSubprojectsMutex.Lock()
+	defer fake.setAffectedSubprojectsMutex.Unlock()
+	fake.SetAffectedSubprojectsStub = stub
+}
+
+func (fake *FakeKEP) SetAffectedSubprojectsArgsForCall(i int) []string {
+	fake.setAffectedSubprojectsMutex.RLock()
+	defer fake.setAffectedSubprojectsMutex.RUnlock()
+	argsForCall := fake.setAffectedSubprojectsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKEP) SetParticipatingSIGs(arg1 []string) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.setParticipatingSIGsMutex.Lock()
+	fake.setParticipatingSIGsArgsForCall = append(fake.setParticipatingSIGsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.SetParticipatingSIGsStub
+	fake.recordInvocation("SetParticipatingSIGs", []interface{}{arg1Copy})
+	fake.setParticipatingSIGsMutex.Unlock()
+	if stub != nil {
+		fake.SetParticipatingSIGsStub(arg1)
+	}
+}
+
+func (fake *FakeKEP) SetParticipatingSIGsCallCount() int {
+	fake.setParticipatingSIGsMutex.RLock()
+	defer fake.setParticipatingSIGsMutex.RUnlock()
+	return len(fake.setParticipatingSIGsArgsForCall)
+}
+
+func (fake *FakeKEP) SetParticipatingSIGsCalls(stub func([]string)) {
+	fake.setParticipatingSIGsMutex.Lock()
+	defer fake.setParticipatingSIGsMutex.Unlock()
+	fake.SetParticipatingSIGsStub = stub
+}
+
+func (fake *FakeKEP) SetParticipatingSIGsArgsForCall(i int) []string {
+	fake.setParticipatingSIGsMutex.RLock()
+	defer fake.setParticipatingSIGsMutex.RUnlock()
+	argsForCall := fake.setParticipatingSIGsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKEP) SetRouting(arg1 metadata.RoutingInfoProvider) {
+	fake.setRoutingMutex.Lock()
+	fake.setRoutingArgsForCall = append(fake.setRoutingArgsForCall, struct {
+		arg1 metadata.RoutingInfoProvider
+	}{arg1})
+	stub := fake.SetRoutingStub
+	fake.recordInvocation("SetRouting", []interface{}{arg1})
+	fake.setRoutingMutex.Unlock()
+	if stub != nil {
+		fake.SetRoutingStub(arg1)
+	}
+}
+
+func (fake *FakeKEP) SetRoutingCallCount() int {
+	fake.setRoutingMutex.RLock()
+	defer fake.setRoutingMutex.RUnlock()
+	return len(fake.setRoutingArgsForCall)
+}
+
+func (fake *FakeKEP) SetRoutingCalls(stub func(metadata.RoutingInfoProvider)) {
+	fake.setRoutingMutex.Lock()
+	defer fake.setRoutingMutex.Unlock()
+	fake.SetRoutingStub = stub
+}
+
+func (fake *FakeKEP) SetRoutingArgsForCall(i int) metadata.RoutingInfoProvider {
+	fake.setRoutingMutex.RLock()
+	defer fake.setRoutingMutex.RUnlock()
+	argsForCall := fake.setRoutingArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeKEP) SetState(arg1 states.Name) {
 	fake.setStateMutex.Lock()
 	fake.setStateArgsForCall = append(fake.setStateArgsForCall, struct {
 		arg1 states.Name
 	}{arg1})
+	stub := fake.SetStateStub
 	fake.recordInvocation("SetState", []interface{}{arg1})
 	fake.setStateMutex.Unlock()
-	if fake.SetStateStub != nil {
+	if stub != nil {
 		fake.SetStateStub(arg1)
 	}
 }
@@ -1256,15 +1593,16 @@ func (fake *FakeKEP) ShortID() int {
 	ret, specificReturn := fake.shortIDReturnsOnCall[len(fake.shortIDArgsForCall)]
 	fake.shortIDArgsForCall = append(fake.shortIDArgsForCall, struct {
 	}{})
+	stub := fake.ShortIDStub
+	fakeReturns := fake.shortIDReturns
 	fake.recordInvocation("ShortID", []interface{}{})
 	fake.shortIDMutex.Unlock()
-	if fake.ShortIDStub != nil {
-		return fake.ShortIDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.shortIDReturns
 	return fakeReturns.result1
 }
 
@@ -1308,15 +1646,16 @@ func (fake *FakeKEP) State() states.Name {
 	ret, specificReturn := fake.stateReturnsOnCall[len(fake.stateArgsForCall)]
 	fake.stateArgsForCall = append(fake.stateArgsForCall, struct {
 	}{})
+	stub := fake.StateStub
+	fakeReturns := fake.stateReturns
 	fake.recordInvocation("State", []interface{}{})
 	fake.stateMutex.Unlock()
-	if fake.StateStub != nil {
-		return fake.StateStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.stateReturns
 	return fakeReturns.result1
 }
 
@@ -1360,15 +1699,16 @@ func (fake *FakeKEP) SupersededBy() []string {
 	ret, specificReturn := fake.supersededByReturnsOnCall[len(fake.supersededByArgsForCall)]
 	fake.supersededByArgsForCall = append(fake.supersededByArgsForCall, struct {
 	}{})
+	stub := fake.SupersededByStub
+	fakeReturns := fake.supersededByReturns
 	fake.recordInvocation("SupersededBy", []interface{}{})
 	fake.supersededByMutex.Unlock()
-	if fake.SupersededByStub != nil {
-		return fake.SupersededByStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.supersededByReturns
 	return fakeReturns.result1
 }
 
@@ -1412,15 +1752,16 @@ func (fake *FakeKEP) Title() string {
 	ret, specificReturn := fake.titleReturnsOnCall[len(fake.titleArgsForCall)]
 	fake.titleArgsForCall = append(fake.titleArgsForCall, struct {
 	}{})
+	stub := fake.TitleStub
+	fakeReturns := fake.titleReturns
 	fake.recordInvocation("Title", []interface{}{})
 	fake.titleMutex.Unlock()
-	if fake.TitleStub != nil {
-		return fake.TitleStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.titleReturns
 	return fakeReturns.result1
 }
 
@@ -1464,15 +1805,16 @@ func (fake *FakeKEP) UniqueID() string {
 	ret, specificReturn := fake.uniqueIDReturnsOnCall[len(fake.uniqueIDArgsForCall)]
 	fake.uniqueIDArgsForCall = append(fake.uniqueIDArgsForCall, struct {
 	}{})
+	stub := fake.UniqueIDStub
+	fakeReturns := fake.uniqueIDReturns
 	fake.recordInvocation("UniqueID", []interface{}{})
 	fake.uniqueIDMutex.Unlock()
-	if fake.UniqueIDStub != nil {
-		return fake.UniqueIDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uniqueIDReturns
 	return fakeReturns.result1
 }
 
@@ -1515,9 +1857,10 @@ func (fake *FakeKEP) Unlock() {
 	fake.unlockMutex.Lock()
 	fake.unlockArgsForCall = append(fake.unlockArgsForCall, struct {
 	}{})
+	stub := fake.UnlockStub
 	fake.recordInvocation("Unlock", []interface{}{})
 	fake.unlockMutex.Unlock()
-	if fake.UnlockStub != nil {
+	if stub != nil {
 		fake.UnlockStub()
 	}
 }
@@ -1534,47 +1877,15 @@ func (fake *FakeKEP) UnlockCalls(stub func()) {
 	fake.UnlockStub = stub
 }
 
-func (fake *FakeKEP) UnsafeAddSectionLocations(arg1 []string) {
-	var arg1Copy []string
-	if arg1 != nil {
-		arg1Copy = make([]string, len(arg1))
-		copy(arg1Copy, arg1)
-	}
-	fake.unsafeAddSectionLocationsMutex.Lock()
-	fake.unsafeAddSectionLocationsArgsForCall = append(fake.unsafeAddSectionLocationsArgsForCall, struct {
-		arg1 []string
-	}{arg1Copy})
-	fake.recordInvocation("UnsafeAddSectionLocations", []interface{}{arg1Copy})
-	fake.unsafeAddSectionLocationsMutex.Unlock()
-	if fake.UnsafeAddSectionLocationsStub != nil {
-		fake.UnsafeAddSectionLocationsStub(arg1)
-	}
-}
-
-func (fake *FakeKEP) UnsafeAddSectionLocationsCallCount() int {
-	fake.unsafeAddSectionLocationsMutex.RLock()
-	defer fake.unsafeAddSectionLocationsMutex.RUnlock()
-	return len(fake.unsafeAddSectionLocationsArgsForCall)
-}
-
-func (fake *FakeKEP) UnsafeAddSectionLocationsCalls(stub func([]string)) {
-	fake.unsafeAddSectionLocationsMutex.Lock()
-	defer fake.unsafeAddSectionLocationsMutex.Unlock()
-	fake.UnsafeAddSectionLocationsStub = stub
-}
-
-func (fake *FakeKEP) UnsafeAddSectionLocationsArgsForCall(i int) []string {
-	fake.unsafeAddSectionLocationsMutex.RLock()
-	defer fake.unsafeAddSectionLocationsMutex.RUnlock()
-	argsForCall := fake.unsafeAddSectionLocationsArgsForCall[i]
-	return argsForCall.arg1
-}
-
 func (fake *FakeKEP) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addApproversMutex.RLock()
 	defer fake.addApproversMutex.RUnlock()
+	fake.addEventMutex.RLock()
+	defer fake.addEventMutex.RUnlock()
+	fake.addPullRequestMutex.RLock()
+	defer fake.addPullRequestMutex.RUnlock()
 	fake.addReviewersMutex.RLock()
 	defer fake.addReviewersMutex.RUnlock()
 	fake.addSectionLocationsMutex.RLock()
@@ -1593,6 +1904,8 @@ func (fake *FakeKEP) Invocations() map[string][][]interface{} {
 	defer fake.developmentThemesMutex.RUnlock()
 	fake.editorsMutex.RLock()
 	defer fake.editorsMutex.RUnlock()
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
 	fake.kubernetesWideMutex.RLock()
 	defer fake.kubernetesWideMutex.RUnlock()
 	fake.lastUpdatedMutex.RLock()
@@ -1605,6 +1918,8 @@ func (fake *FakeKEP) Invocations() map[string][][]interface{} {
 	defer fake.participatingSIGsMutex.RUnlock()
 	fake.persistMutex.RLock()
 	defer fake.persistMutex.RUnlock()
+	fake.pullRequestsMutex.RLock()
+	defer fake.pullRequestsMutex.RUnlock()
 	fake.replacesMutex.RLock()
 	defer fake.replacesMutex.RUnlock()
 	fake.reviewersMutex.RLock()
@@ -1613,6 +1928,12 @@ func (fake *FakeKEP) Invocations() map[string][][]interface{} {
 	defer fake.sIGWideMutex.RUnlock()
 	fake.sectionLocationsMutex.RLock()
 	defer fake.sectionLocationsMutex.RUnlock()
+	fake.setAffectedSubprojectsMutex.RLock()
+	defer fake.setAffectedSubprojectsMutex.RUnlock()
+	fake.setParticipatingSIGsMutex.RLock()
+	defer fake.setParticipatingSIGsMutex.RUnlock()
+	fake.setRoutingMutex.RLock()
+	defer fake.setRoutingMutex.RUnlock()
 	fake.setStateMutex.RLock()
 	defer fake.setStateMutex.RUnlock()
 	fake.shortIDMutex.RLock()
@@ -1627,8 +1948,6 @@ func (fake *FakeKEP) Invocations() map[string][][]interface{} {
 	defer fake.uniqueIDMutex.RUnlock()
 	fake.unlockMutex.RLock()
 	defer fake.unlockMutex.RUnlock()
-	fake.unsafeAddSectionLocationsMutex.RLock()
-	defer fake.unsafeAddSectionLocationsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,110 @@
+package metadata
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// SchemaVersion is the version of the metadata.yaml format written by this package
+const SchemaVersion = 1
+
+// Migration upgrades a metadata.yaml document from schema version From to From+1
+type Migration struct {
+	From        int
+	Description string
+	Migrate     func(doc yaml.MapSlice) (yaml.MapSlice, error)
+}
+
+// Migrations upgrade older metadata.yaml documents one schema version at a
+// time, in order. Bump SchemaVersion and add a Migration whenever the format changes
+var Migrations = []Migration{
+	{
+		From:        0,
+		Description: "record the schema version of metadata written before schema versioning",
+		Migrate:     func(doc yaml.MapSlice) (yaml.MapSlice, error) { return doc, nil },
+	},
+}
+
+// Migrate upgrades a metadata.yaml document to SchemaVersion, returning the
+// upgraded document and the migrations applied, none when the document is
+// already current. Keys unknown to this package are kept in their original order
+func Migrate(b []byte) ([]byte, []Migration, error) {
+	doc := yaml.MapSlice{}
+	err := yaml.Unmarshal(b, &doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version, err := schemaVersionOf(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if version > SchemaVersion {
+		return nil, nil, fmt.Errorf("metadata schema version: %d is newer than the supported schema version: %d. Try updating?", version, SchemaVersion)
+	}
+
+	if version == SchemaVersion {
+		return b, nil, nil
+	}
+
+	applied := []Migration{}
+	for _, m := range Migrations {
+		if m.From != version {
+			continue
+		}
+
+		doc, err = m.Migrate(doc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("migrating metadata from schema version: %d. %s", m.From, err)
+		}
+
+		applied = append(applied, m)
+		version++
+	}
+
+	if version != SchemaVersion {
+		return nil, nil, fmt.Errorf("no migration from metadata schema version: %d", version)
+	}
+
+	upgraded, err := yaml.Marshal(withSchemaVersion(doc, version))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return upgraded, applied, nil
+}
+
+// schemaVersionOf returns the schema version recorded in doc, metadata
+// written before schema versioning is version 0
+func schemaVersionOf(doc yaml.MapSlice) (int, error) {
+	for _, item := range doc {
+		if item.Key != schemaVersionKey {
+			continue
+		}
+
+		version, ok := item.Value.(int)
+		if !ok {
+			return 0, fmt.Errorf("invalid metadata schema version: %v", item.Value)
+		}
+
+		return version, nil
+	}
+
+	return 0, nil
+}
+
+// withSchemaVersion records version in doc
+func withSchemaVersion(doc yaml.MapSlice, version int) yaml.MapSlice {
+	for i := range doc {
+		if doc[i].Key == schemaVersionKey {
+			doc[i].Value = version
+			return doc
+		}
+	}
+
+	return append(yaml.MapSlice{{Key: schemaVersionKey, Value: version}}, doc...)
+}
+
+const schemaVersionKey = "schema_version"
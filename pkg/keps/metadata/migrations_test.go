@@ -0,0 +1,74 @@
+package metadata_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"strings"
+
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/states"
+)
+
+var _ = Describe("metadata schema migrations", func() {
+	const unversioned = `authors:
+- dchen1107
+title: kubelet
+state: provisional
+owning_sig: sig-node
+`
+
+	It("upgrades metadata written before schema versioning", func() {
+		migrated, applied, err := metadata.Migrate([]byte(unversioned))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].From).To(Equal(0))
+		Expect(string(migrated)).To(Equal("schema_version: 1\n" + unversioned))
+	})
+
+	It("keeps keys it does not know about in their original order", func() {
+		withUnknown := "zz_last_first: true\n" + unversioned + "aa_first_last: [one, two]\n"
+
+		migrated, _, err := metadata.Migrate([]byte(withUnknown))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(migrated)).To(HavePrefix("schema_version: 1\nzz_last_first: true\n"))
+		Expect(string(migrated)).To(HaveSuffix("aa_first_last:\n- one\n- two\n"))
+	})
+
+	It("leaves current metadata untouched", func() {
+		current := "schema_version: 1\n" + unversioned
+
+		migrated, applied, err := metadata.Migrate([]byte(current))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied).To(BeEmpty())
+		Expect(string(migrated)).To(Equal(current))
+	})
+
+	It("refuses metadata with a newer or invalid schema version", func() {
+		_, _, err := metadata.Migrate([]byte("schema_version: 1000\n" + unversioned))
+		Expect(err).To(MatchError(ContainSubstring("newer than the supported schema version")))
+
+		_, _, err = metadata.Migrate([]byte("schema_version: one\n" + unversioned))
+		Expect(err).To(MatchError(ContainSubstring("invalid metadata schema version")))
+
+		_, err = metadata.FromBytes([]byte("schema_version: 1000\n" + unversioned))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reads older metadata as the current schema version", func() {
+		m, err := metadata.FromBytes([]byte(unversioned))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(m.Title()).To(Equal("kubelet"))
+		Expect(m.State()).To(Equal(states.Provisional))
+	})
+
+	It("has a migration to each schema version", func() {
+		Expect(metadata.Migrations).To(HaveLen(metadata.SchemaVersion))
+		for i, m := range metadata.Migrations {
+			Expect(m.From).To(Equal(i))
+			Expect(strings.TrimSpace(m.Description)).ToNot(BeEmpty())
+		}
+	})
+})
@@ -0,0 +1,84 @@
+package metadata
+
+import (
+	"time"
+)
+
+// PullRequestPurpose describes why a pull request associated with a KEP was opened
+type PullRequestPurpose string
+
+const (
+	PurposePropose        PullRequestPurpose = "propose"
+	PurposeAccept         PullRequestPurpose = "accept"
+	PurposePlan           PullRequestPurpose = "plan"
+	PurposeApprove        PullRequestPurpose = "approve"
+	PurposeImplementation PullRequestPurpose = "implementation"
+)
+
+// PullRequestState is the last known state of a pull request associated with a KEP
+type PullRequestState string
+
+const (
+	PullRequestOpen   PullRequestState = "open"
+	PullRequestClosed PullRequestState = "closed" // closed without merging
+	PullRequestMerged PullRequestState = "merged"
+)
+
+// PullRequest is a GitHub pull request associated with a KEP
+type PullRequest struct {
+	URL     string             `yaml:"url"`
+	Number  int                `yaml:"number"`
+	Purpose PullRequestPurpose `yaml:"purpose"`
+	State   PullRequestState   `yaml:"state"`
+}
+
+// Event is an entry in the log of what has happened to a KEP
+type Event struct {
+	At          time.Time `yaml:"at"`
+	Description string    `yaml:"description"`
+}
+
+// pull requests
+
+func (k *kep) AddPullRequest(pr PullRequest) {
+	k.Lock()
+	defer k.Unlock()
+
+	for i := range k.PullRequestsField {
+		if k.PullRequestsField[i].URL == pr.URL {
+			k.PullRequestsField[i] = pr
+			return
+		}
+	}
+
+	k.PullRequestsField = append(k.PullRequestsField, pr)
+}
+
+func (k *kep) PullRequests() []PullRequest {
+	k.RLock()
+	defer k.RUnlock()
+
+	prs := make([]PullRequest, len(k.PullRequestsField))
+	copy(prs, k.PullRequestsField)
+
+	return prs
+}
+
+// events
+
+func (k *kep) AddEvent(description string) {
+	k.Lock()
+	defer k.Unlock()
+
+	k.EventsField = append(k.EventsField, Event{At: time.Now().UTC(), Description: description})
+}
+
+func (k *kep) Events() []Event {
+	k.RLock()
+	defer k.RUnlock()
+
+	events := make([]Event, len(k.EventsField))
+	copy(events, k.EventsField)
+
+	return events
+}
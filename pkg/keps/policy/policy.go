@@ -0,0 +1,241 @@
+package policy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v2"
+
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/states"
+)
+
+// Filename is the name of the policy file at the root of the KEP content
+const Filename = "policy.yaml"
+
+// A Policy holds KEPs owned by particular SIGs to checks beyond check.Builtin.
+// For example
+//
+//	rules:
+//	- sigs: [sig-node]
+//	  states: [implementable, implemented]
+//	  checks: [stability-theme, non-author-approver]
+//	  reason: SIG Node graduates features with a focus on stability
+//
+// Checks are named as registered in pkg/keps/check. A rule without sigs or
+// states applies to every SIG or state and a rule without a severity reports
+// problems as errors
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+	path  string `yaml:"-"` // do not persist this
+}
+
+// A Rule applies checks to KEPs owned by one of SIGs in one of States
+type Rule struct {
+	SIGs     []string       `yaml:"sigs,omitempty"`
+	States   []states.Name  `yaml:"states,omitempty"`
+	Checks   []string       `yaml:"checks"`
+	Severity check.Severity `yaml:"severity,omitempty"`
+	Reason   string         `yaml:"reason,omitempty"`
+}
+
+// Load reads the policy at the root of the KEP content, returning an empty
+// Policy when there is none
+func Load(contentRoot string) (*Policy, error) {
+	p := filepath.Join(contentRoot, Filename)
+
+	policyBytes, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return &Policy{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	pol, err := Parse(policyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy: %s. %s", p, err)
+	}
+
+	pol.path = p
+
+	return pol, nil
+}
+
+// Parse parses and validates a policy
+func Parse(b []byte) (*Policy, error) {
+	pol := &Policy{}
+	err := yaml.UnmarshalStrict(b, pol)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs *multierror.Error
+	for i, r := range pol.Rules {
+		if len(r.Checks) == 0 {
+			errs = multierror.Append(errs, fmt.Errorf("rule %d names no checks", i+1))
+		}
+
+		for _, name := range r.Checks {
+			if _, found := check.Lookup(name); !found {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d names unknown check: %s", i+1, name))
+			}
+		}
+
+		if r.Severity != "" {
+			_, err := check.ParseSeverity(string(r.Severity))
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return pol, errs.ErrorOrNil()
+}
+
+// For returns the rules which apply to a KEP owned by owningSIG in state
+func (p *Policy) For(owningSIG string, state states.Name) []check.Rule {
+	applied := []check.Rule{}
+	for _, r := range p.Rules {
+		if !r.appliesTo(owningSIG, state) {
+			continue
+		}
+
+		for _, name := range r.Checks {
+			applied = append(applied, check.Rule{Check: name, States: r.States, Severity: r.severity()})
+		}
+	}
+
+	return applied
+}
+
+// Checks returns the checks of the rules which apply to a KEP, stored under
+// contentRoot, owned by owningSIG in state
+func (p *Policy) Checks(contentRoot string, owningSIG string, state states.Name) ([]check.That, error) {
+	checks := []check.That{}
+	for _, r := range p.For(owningSIG, state) {
+		c, err := r.That(contentRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
+
+// An Explanation describes a check which applies to a KEP and why
+type Explanation struct {
+	Check       string
+	Description string
+	Severity    check.Severity
+	Reason      string
+}
+
+// Explain returns each check which applies to a KEP owned by owningSIG in
+// state, first those of check.Builtin and then those of the policy
+func (p *Policy) Explain(owningSIG string, state states.Name) []Explanation {
+	explanations := []Explanation{}
+	for _, r := range check.Builtin {
+		if !r.AppliesTo(state) {
+			continue
+		}
+
+		reason := "required of every KEP"
+		if len(r.States) > 0 {
+			reason = fmt.Sprintf("required of %s KEPs", joinStates(r.States))
+		}
+
+		explanations = append(explanations, explain(r.Check, r.Severity, reason))
+	}
+
+	for i, r := range p.Rules {
+		if !r.appliesTo(owningSIG, state) {
+			continue
+		}
+
+		for _, name := range r.Checks {
+			explanations = append(explanations, explain(name, r.severity(), p.reason(i, r)))
+		}
+	}
+
+	return explanations
+}
+
+func explain(name string, severity check.Severity, reason string) Explanation {
+	registered, _ := check.Lookup(name)
+
+	return Explanation{
+		Check:       name,
+		Description: registered.Description,
+		Severity:    severity,
+		Reason:      reason,
+	}
+}
+
+// reason describes why the ith rule applies
+func (p *Policy) reason(i int, r Rule) string {
+	source := Filename
+	if p.path != "" {
+		source = p.path
+	}
+
+	scope := []string{}
+	if len(r.SIGs) > 0 {
+		scope = append(scope, "sigs: "+strings.Join(r.SIGs, ", "))
+	}
+
+	if len(r.States) > 0 {
+		scope = append(scope, "states: "+joinStates(r.States))
+	}
+
+	if len(scope) == 0 {
+		scope = append(scope, "every KEP")
+	}
+
+	reason := fmt.Sprintf("%s rule %d (%s)", source, i+1, strings.Join(scope, "; "))
+	if r.Reason != "" {
+		reason = reason + ": " + r.Reason
+	}
+
+	return reason
+}
+
+func (r Rule) appliesTo(owningSIG string, state states.Name) bool {
+	if !(check.Rule{States: r.States}).AppliesTo(state) {
+		return false
+	}
+
+	if len(r.SIGs) == 0 {
+		return true
+	}
+
+	for _, sig := range r.SIGs {
+		if sig == owningSIG {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) severity() check.Severity {
+	if r.Severity == "" {
+		return check.Error
+	}
+
+	return check.Severity(strings.ToLower(string(r.Severity)))
+}
+
+func joinStates(given []states.Name) string {
+	names := []string{}
+	for _, s := range given {
+		names = append(names, string(s))
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,106 @@
+package policy_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/policy"
+	"github.com/calebamiles/keps/pkg/keps/states"
+)
+
+var _ = Describe("KEP policy", func() {
+	const policyYaml = `
+rules:
+- sigs: [sig-node]
+  states: [implementable, implemented]
+  checks: [stability-theme, non-author-approver]
+  reason: SIG Node graduates features with a focus on stability
+- checks: [has-editors]
+  severity: warning
+`
+
+	Describe("Load()", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "kep-policy")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		It("returns an empty policy when there is no policy file", func() {
+			pol, err := policy.Load(tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pol.Rules).To(BeEmpty())
+			Expect(pol.Checks(tmpDir, "sig-node", states.Implementable)).To(BeEmpty())
+		})
+
+		It("reads the policy file at the content root", func() {
+			Expect(ioutil.WriteFile(filepath.Join(tmpDir, policy.Filename), []byte(policyYaml), os.ModePerm)).To(Succeed())
+
+			pol, err := policy.Load(tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pol.Rules).To(HaveLen(2))
+		})
+
+		It("rejects policies naming unknown checks or severities", func() {
+			invalid := "rules:\n- checks: [not-a-check]\n  severity: fatal\n- sigs: [sig-node]\n"
+			Expect(ioutil.WriteFile(filepath.Join(tmpDir, policy.Filename), []byte(invalid), os.ModePerm)).To(Succeed())
+
+			_, err := policy.Load(tmpDir)
+			Expect(err).To(MatchError(ContainSubstring("unknown check: not-a-check")))
+			Expect(err).To(MatchError(ContainSubstring("invalid severity: fatal")))
+			Expect(err).To(MatchError(ContainSubstring("rule 2 names no checks")))
+		})
+	})
+
+	Describe("For()", func() {
+		It("returns the rules matching the owning SIG and state", func() {
+			pol, err := policy.Parse([]byte(policyYaml))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pol.For("sig-node", states.Implementable)).To(Equal([]check.Rule{
+				{Check: "stability-theme", States: []states.Name{states.Implementable, states.Implemented}, Severity: check.Error},
+				{Check: "non-author-approver", States: []states.Name{states.Implementable, states.Implemented}, Severity: check.Error},
+				{Check: "has-editors", Severity: check.Warning},
+			}))
+
+			Expect(pol.For("sig-node", states.Provisional)).To(Equal([]check.Rule{{Check: "has-editors", Severity: check.Warning}}))
+			Expect(pol.For("sig-apps", states.Implementable)).To(Equal([]check.Rule{{Check: "has-editors", Severity: check.Warning}}))
+		})
+	})
+
+	Describe("Explain()", func() {
+		It("describes the built in checks and the policy rules which apply", func() {
+			pol, err := policy.Parse([]byte(policyYaml))
+			Expect(err).ToNot(HaveOccurred())
+
+			explanations := pol.Explain("sig-node", states.Implementable)
+
+			byCheck := map[string]policy.Explanation{}
+			for _, e := range explanations {
+				byCheck[e.Check] = e
+			}
+
+			Expect(byCheck["has-uuid"].Reason).To(Equal("required of every KEP"))
+			Expect(byCheck["valid-for-implementable"].Reason).To(Equal("required of implementable KEPs"))
+			Expect(byCheck).ToNot(HaveKey("valid-for-provisional"))
+
+			Expect(byCheck["stability-theme"].Severity).To(Equal(check.Error))
+			Expect(byCheck["stability-theme"].Description).ToNot(BeEmpty())
+			Expect(byCheck["stability-theme"].Reason).To(Equal("policy.yaml rule 1 (sigs: sig-node; states: implementable, implemented): SIG Node graduates features with a focus on stability"))
+
+			Expect(byCheck["has-editors"].Severity).To(Equal(check.Warning))
+			Expect(byCheck["has-editors"].Reason).To(Equal("policy.yaml rule 2 (every KEP)"))
+		})
+	})
+})
@@ -0,0 +1,171 @@
+package themes
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// CompiledSource is the Source of the development themes compiled into the KEP tooling
+	CompiledSource = "compiled"
+
+	// Filename is the name of the file listing development themes, kept at the
+	// root of the KEP content alongside sigs.yaml
+	Filename = "themes.yaml"
+)
+
+// A Theme is a development theme a KEP can fall into, such as stability, used
+// by SIG PM to plan and report on the roadmap
+type Theme struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Owners      []string `yaml:"owners,omitempty"` // SIGs or people responsible for the theme
+}
+
+var compiled = &registry{
+	source: CompiledSource,
+	themes: []Theme{
+		{
+			Name:        Stability,
+			Description: "improving the reliability, quality and maturity of existing features",
+			Owners:      []string{"sig-pm"},
+		},
+	},
+}
+
+var (
+	activeLock sync.RWMutex
+	active     = compiled
+)
+
+type registry struct {
+	source string
+	themes []Theme
+}
+
+func current() *registry {
+	activeLock.RLock()
+	defer activeLock.RUnlock()
+
+	return active
+}
+
+// themesYaml is the format of a themes.yaml
+type themesYaml struct {
+	Themes []Theme `yaml:"themes"`
+}
+
+// Load replaces the development themes compiled into the KEP tooling with the
+// themes listed in the themes.yaml at p
+func Load(p string) error {
+	list, err := read(p)
+	if err != nil {
+		return err
+	}
+
+	if len(list.Themes) == 0 {
+		return fmt.Errorf("no development themes listed in: %s", p)
+	}
+
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	active = &registry{source: p, themes: list.Themes}
+
+	return nil
+}
+
+// UseCompiled discards any development themes loaded by Load
+func UseCompiled() {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	active = compiled
+}
+
+// Source returns where the development themes in use came from, either the
+// path given to Load or CompiledSource
+func Source() string {
+	return current().source
+}
+
+// All returns every known development theme ordered by name
+func All() []Theme {
+	all := append([]Theme{}, current().themes...)
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	return all
+}
+
+// Get returns the known development theme called name
+func Get(name string) (Theme, bool) {
+	for _, t := range current().themes {
+		if t.Name == name {
+			return t, true
+		}
+	}
+
+	return Theme{}, false
+}
+
+// Exists returns whether name is a known development theme
+func Exists(name string) bool {
+	_, found := Get(name)
+	return found
+}
+
+// Add adds t to the themes.yaml at p and to the themes in use. When p does not
+// exist it is created listing the themes in use along with t
+func Add(p string, t Theme) error {
+	if t.Name == "" {
+		return fmt.Errorf("development themes must have a name")
+	}
+
+	list, err := read(p)
+	switch {
+	case os.IsNotExist(err):
+		list = &themesYaml{Themes: All()}
+	case err != nil:
+		return err
+	}
+
+	for _, existing := range list.Themes {
+		if existing.Name == t.Name {
+			return fmt.Errorf("development theme: %s already exists in: %s", t.Name, p)
+		}
+	}
+
+	list.Themes = append(list.Themes, t)
+
+	themesBytes, err := yaml.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(p, themesBytes, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return Load(p)
+}
+
+func read(p string) (*themesYaml, error) {
+	themesBytes, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &themesYaml{}
+	err = yaml.Unmarshal(themesBytes, list)
+	if err != nil {
+		return nil, fmt.Errorf("parsing development themes from %s: %s", p, err)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,74 @@
+package themes_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps/themes"
+)
+
+var _ = Describe("the development theme registry", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "kep-themes")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		themes.UseCompiled()
+		os.RemoveAll(tmpDir)
+	})
+
+	It("knows the compiled development themes", func() {
+		Expect(themes.Source()).To(Equal(themes.CompiledSource))
+		Expect(themes.Exists(themes.Stability)).To(BeTrue())
+		Expect(themes.Exists("stabilty")).To(BeFalse())
+
+		stability, found := themes.Get(themes.Stability)
+		Expect(found).To(BeTrue())
+		Expect(stability.Description).ToNot(BeEmpty())
+		Expect(stability.Owners).ToNot(BeEmpty())
+	})
+
+	It("uses the development themes listed in a themes.yaml in place of the compiled themes", func() {
+		p := filepath.Join(tmpDir, themes.Filename)
+		themesYaml := "themes:\n- name: security\n  description: hardening Kubernetes\n  owners: [sig-auth]\n"
+		Expect(ioutil.WriteFile(p, []byte(themesYaml), os.ModePerm)).To(Succeed())
+
+		Expect(themes.Load(p)).To(Succeed())
+		Expect(themes.Source()).To(Equal(p))
+		Expect(themes.All()).To(Equal([]themes.Theme{{Name: "security", Description: "hardening Kubernetes", Owners: []string{"sig-auth"}}}))
+		Expect(themes.Exists(themes.Stability)).To(BeFalse())
+
+		Expect(ioutil.WriteFile(p, []byte("themes: []\n"), os.ModePerm)).To(Succeed())
+		Expect(themes.Load(p)).To(MatchError(ContainSubstring("no development themes listed")))
+	})
+
+	It("adds development themes to a themes.yaml, creating it from the themes in use", func() {
+		p := filepath.Join(tmpDir, themes.Filename)
+
+		err := themes.Add(p, themes.Theme{Name: "extensibility", Description: "growing Kubernetes without changing its core"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(themes.Source()).To(Equal(p))
+
+		names := []string{}
+		for _, t := range themes.All() {
+			names = append(names, t.Name)
+		}
+
+		Expect(names).To(Equal([]string{"extensibility", themes.Stability}))
+
+		err = themes.Add(p, themes.Theme{Name: "extensibility"})
+		Expect(err).To(MatchError(ContainSubstring("already exists")))
+
+		themes.UseCompiled()
+		Expect(themes.Load(p)).To(Succeed())
+		Expect(themes.Exists("extensibility")).To(BeTrue())
+	})
+})
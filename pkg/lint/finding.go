@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"github.com/calebamiles/keps/pkg/keps/check"
+)
+
+// A Finding is a single problem reported by a Rule
+type Finding struct {
+	Path     string         `json:"path"` // file the finding is about, a metadata.yaml or keps.yaml
+	Rule     string         `json:"rule"`
+	Severity check.Severity `json:"severity"`
+	Message  string         `json:"message"`
+}
+
+// AnyAtLeast returns whether any finding is as serious as threshold
+func AnyAtLeast(findings []Finding, threshold check.Severity) bool {
+	for _, f := range findings {
+		if f.Severity.AtLeast(threshold) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findingsFrom returns a Finding for each problem reported in err, the result
+// of a check, see check.Findings
+func findingsFrom(path string, rule string, err error) []Finding {
+	findings := []Finding{}
+	for _, f := range check.Findings(err) {
+		findings = append(findings, Finding{
+			Path:     path,
+			Rule:     rule,
+			Severity: f.Severity,
+			Message:  f.Message,
+		})
+	}
+
+	return findings
+}
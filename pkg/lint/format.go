@@ -0,0 +1,185 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/calebamiles/keps/pkg/keps/check"
+)
+
+// Format is how findings are written by Write
+type Format string
+
+const (
+	TextFormat   Format = "text"
+	JSONFormat   Format = "json"
+	GitHubFormat Format = "github" // GitHub Actions workflow commands
+	SARIFFormat  Format = "sarif"
+)
+
+// ParseFormat returns the Format named by s
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case TextFormat, JSONFormat, GitHubFormat, SARIFFormat:
+		return f, nil
+	}
+
+	return "", fmt.Errorf("invalid format: %s. Must be one of: %s, %s, %s, %s", s, TextFormat, JSONFormat, GitHubFormat, SARIFFormat)
+}
+
+// Write writes findings to w in the given format
+func Write(w io.Writer, format Format, findings []Finding) error {
+	switch format {
+	case TextFormat:
+		return writeText(w, findings)
+	case JSONFormat:
+		return writeJSON(w, findings)
+	case GitHubFormat:
+		return writeGitHub(w, findings)
+	case SARIFFormat:
+		return writeSARIF(w, findings)
+	}
+
+	return fmt.Errorf("invalid format: %s", format)
+}
+
+func writeText(w io.Writer, findings []Finding) error {
+	for _, f := range findings {
+		_, err := fmt.Fprintf(w, "%s: %s [%s] %s\n", f.Path, f.Severity, f.Rule, f.Message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeJSON(w io.Writer, findings []Finding) error {
+	if findings == nil {
+		findings = []Finding{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(findings)
+}
+
+// see https://docs.github.com/en/actions/reference/workflow-commands-for-github-actions
+var gitHubLevel = map[check.Severity]string{
+	check.Error:   "error",
+	check.Warning: "warning",
+	check.Info:    "notice",
+}
+
+func writeGitHub(w io.Writer, findings []Finding) error {
+	for _, f := range findings {
+		_, err := fmt.Fprintf(w, "::%s file=%s,title=%s::%s\n",
+			gitHubLevel[f.Severity],
+			escapeGitHubProperty(filepath.ToSlash(f.Path)),
+			escapeGitHubProperty(f.Rule),
+			escapeGitHubData(f.Message),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer(":", "%3A", ",", "%2C").Replace(escapeGitHubData(s))
+}
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName     = "kepctl"
+)
+
+var sarifLevel = map[check.Severity]string{
+	check.Error:   "error",
+	check.Warning: "warning",
+	check.Info:    "note",
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+	} `json:"physicalLocation"`
+}
+
+func writeSARIF(w io.Writer, findings []Finding) error {
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: toolName, Rules: []sarifRule{}}},
+		Results: []sarifResult{},
+	}
+
+	seenRule := make(map[string]bool)
+	for _, f := range findings {
+		if !seenRule[f.Rule] {
+			seenRule[f.Rule] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: f.Rule})
+		}
+
+		location := sarifLocation{}
+		location.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(f.Path)
+
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    f.Rule,
+			Level:     sarifLevel[f.Severity],
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{location},
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(sarifLog{Version: sarifVersion, Schema: sarifSchema, Runs: []sarifRun{run}})
+}
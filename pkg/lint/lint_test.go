@@ -0,0 +1,171 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/metadata/metadatafakes"
+	"github.com/calebamiles/keps/pkg/keps/policy"
+	"github.com/calebamiles/keps/pkg/keps/states"
+
+	"github.com/calebamiles/keps/pkg/lint"
+)
+
+var _ = Describe("linting KEPs", func() {
+	Describe("AnyAtLeast()", func() {
+		It("returns whether any finding is at least as severe as a threshold", func() {
+			findings := []lint.Finding{{Severity: check.Info}, {Severity: check.Warning}}
+			Expect(lint.AnyAtLeast(findings, check.Warning)).To(BeTrue())
+			Expect(lint.AnyAtLeast(findings, check.Error)).To(BeFalse())
+		})
+	})
+
+	Describe("KEP()", func() {
+		It("reports each violation of an applicable rule separately", func() {
+			meta := &metadatafakes.FakeKEP{}
+			meta.ContentDirReturns("content/sig-node/sig-wide/a-kep")
+			meta.OwningSIGReturns("sig-node")
+			meta.SIGWideReturns(true)
+			meta.StateReturns(states.Provisional)
+			meta.ShortIDReturns(metadata.UnsetShortID)
+
+			findings := lint.KEP("content", meta, &policy.Policy{})
+
+			messages := map[string][]string{}
+			severities := map[string]check.Severity{}
+			for _, f := range findings {
+				Expect(f.Path).To(Equal("content/sig-node/sig-wide/a-kep/metadata.yaml"))
+				messages[f.Rule] = append(messages[f.Rule], f.Message)
+				severities[f.Rule] = f.Severity
+			}
+
+			Expect(messages).To(HaveKey("title-is-set"))
+			Expect(messages).To(HaveKey("has-uuid"))
+			Expect(messages["valid-for-provisional"]).To(ContainElement("missing Summary"))
+			Expect(messages["valid-for-provisional"]).To(ContainElement("missing Motivation"))
+			Expect(messages).ToNot(HaveKey("valid-for-implementable"))
+			Expect(messages).ToNot(HaveKey("location-matches-routing"))
+
+			By("taking the severity of each finding from the check")
+			Expect(severities["has-uuid"]).To(Equal(check.Error))
+			Expect(severities["has-development-themes"]).To(Equal(check.Warning))
+			Expect(severities["author-not-reviewer"]).To(Equal(check.Warning))
+		})
+
+		It("runs the policy rules for the owning SIG of the KEP", func() {
+			meta := &metadatafakes.FakeKEP{}
+			meta.ContentDirReturns("content/sig-node/sig-wide/a-kep")
+			meta.OwningSIGReturns("sig-node")
+			meta.SIGWideReturns(true)
+			meta.StateReturns(states.Draft)
+
+			pol, err := policy.Parse([]byte("rules:\n- sigs: [sig-node]\n  checks: [stability-theme]\n- sigs: [sig-apps]\n  checks: [has-editors]\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			rules := []string{}
+			for _, f := range lint.KEP("content", meta, pol) {
+				rules = append(rules, f.Rule)
+			}
+
+			Expect(rules).To(ContainElement("stability-theme"))
+			Expect(rules).ToNot(ContainElement("has-editors"))
+		})
+	})
+
+	Describe("Index()", func() {
+		It("reports duplicate identifiers and disagreement with the KEP index", func() {
+			first := &metadatafakes.FakeKEP{}
+			first.ContentDirReturns("content/sig-node/sig-wide/first")
+			first.UniqueIDReturns("some-uuid")
+			first.ShortIDReturns(42)
+			first.StateReturns(states.Implementable)
+
+			second := &metadatafakes.FakeKEP{}
+			second.ContentDirReturns("content/sig-node/sig-wide/second")
+			second.UniqueIDReturns("some-uuid")
+			second.ShortIDReturns(42)
+			second.StateReturns(states.Implemented)
+
+			findings := lint.Index("content", []string{"content/sig-node/sig-wide/first", "content/sig-node/sig-wide/gone"}, []metadata.KEP{first, second})
+
+			rules := []string{}
+			for _, f := range findings {
+				rules = append(rules, f.Rule)
+			}
+
+			Expect(rules).To(Equal([]string{
+				lint.UniqueUUIDRule,
+				lint.UniqueShortIDRule,
+				lint.IndexLocationRule, // neither KEP exists on disk
+				lint.IndexLocationRule,
+				lint.IndexedRule,
+			}))
+
+			Expect(findings[4].Path).To(Equal("content/sig-node/sig-wide/second/metadata.yaml"))
+			Expect(findings[4].Severity).To(Equal(check.Warning))
+
+			By("skipping index agreement when there is no index")
+			Expect(lint.Index("content", nil, []metadata.KEP{first})).To(BeEmpty())
+		})
+	})
+
+	Describe("Write()", func() {
+		findings := []lint.Finding{
+			{Path: "content/sig-node/sig-wide/a-kep/metadata.yaml", Rule: "title-is-set", Severity: check.Error, Message: "no title set"},
+			{Path: "content/keps.yaml", Rule: "indexed", Severity: check.Info, Message: "a message, with: 100%\nof detail"},
+		}
+
+		It("writes human readable text", func() {
+			out := &bytes.Buffer{}
+			Expect(lint.Write(out, lint.TextFormat, findings)).To(Succeed())
+			Expect(out.String()).To(HavePrefix("content/sig-node/sig-wide/a-kep/metadata.yaml: error [title-is-set] no title set\n"))
+		})
+
+		It("writes JSON", func() {
+			out := &bytes.Buffer{}
+			Expect(lint.Write(out, lint.JSONFormat, findings)).To(Succeed())
+
+			decoded := []lint.Finding{}
+			Expect(json.Unmarshal(out.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(findings))
+
+			out.Reset()
+			Expect(lint.Write(out, lint.JSONFormat, nil)).To(Succeed())
+			Expect(out.String()).To(Equal("[]\n"))
+		})
+
+		It("writes GitHub Actions annotations", func() {
+			out := &bytes.Buffer{}
+			Expect(lint.Write(out, lint.GitHubFormat, findings)).To(Succeed())
+			Expect(out.String()).To(Equal(
+				"::error file=content/sig-node/sig-wide/a-kep/metadata.yaml,title=title-is-set::no title set\n" +
+					"::notice file=content/keps.yaml,title=indexed::a message, with: 100%25%0Aof detail\n",
+			))
+		})
+
+		It("writes SARIF", func() {
+			out := &bytes.Buffer{}
+			Expect(lint.Write(out, lint.SARIFFormat, findings)).To(Succeed())
+
+			decoded := map[string]interface{}{}
+			Expect(json.Unmarshal(out.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded["version"]).To(Equal("2.1.0"))
+
+			run := decoded["runs"].([]interface{})[0].(map[string]interface{})
+			results := run["results"].([]interface{})
+			Expect(results).To(HaveLen(2))
+			Expect(results[1].(map[string]interface{})["level"]).To(Equal("note"))
+			Expect(results[1].(map[string]interface{})["ruleId"]).To(Equal("indexed"))
+		})
+
+		It("rejects unknown formats", func() {
+			_, err := lint.ParseFormat("xml")
+			Expect(err).To(MatchError(ContainSubstring("invalid format: xml")))
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/policy"
+	"github.com/calebamiles/keps/pkg/keps/states"
+)
+
+// Rule IDs of findings not produced by a registered check
+const (
+	MetadataReadableRule = "metadata-readable"
+	UniqueUUIDRule       = "unique-uuid"
+	UniqueShortIDRule    = "unique-short-id"
+	IndexedRule          = "indexed"
+	IndexReadableRule    = "index-readable"
+	IndexLocationRule    = "index-location-exists"
+	PolicyReadableRule   = "policy-readable"
+	RoutingFixedRule     = "routing-fixed"
+)
+
+const (
+	metadataFilename = "metadata.yaml"
+	indexFilename    = "keps.yaml"
+)
+
+// KEP runs the check.Builtin rules, along with those of pol for the owning SIG
+// of the KEP, which apply to the state of a single KEP stored under
+// contentRoot. Findings are reported under the name of the registered check
+func KEP(contentRoot string, meta metadata.KEP, pol *policy.Policy) []Finding {
+	path := filepath.Join(meta.ContentDir(), metadataFilename)
+
+	rules := []check.Rule{}
+	for _, r := range check.Builtin {
+		if r.AppliesTo(meta.State()) {
+			rules = append(rules, r)
+		}
+	}
+
+	rules = append(rules, pol.For(meta.OwningSIG(), meta.State())...)
+
+	findings := []Finding{}
+	for _, r := range rules {
+		c, err := r.That(contentRoot)
+		if err != nil {
+			continue // both check.Builtin and policy.Parse only name registered checks
+		}
+
+		findings = append(findings, findingsFrom(path, r.Check, c(meta))...)
+	}
+
+	return findings
+}
+
+// UnreadablePolicy reports a policy at contentRoot which could not be read
+func UnreadablePolicy(contentRoot string, err error) []Finding {
+	return findingsFrom(filepath.Join(contentRoot, policy.Filename), PolicyReadableRule, err)
+}
+
+// Unreadable reports a KEP whose metadata could not be read from dir
+func Unreadable(dir string, err error) []Finding {
+	return findingsFrom(filepath.Join(dir, metadataFilename), MetadataReadableRule, err)
+}
+
+// UnreadableIndex reports a KEP index at contentRoot which could not be read
+func UnreadableIndex(contentRoot string, err error) []Finding {
+	return findingsFrom(filepath.Join(contentRoot, indexFilename), IndexReadableRule, err)
+}
+
+// Index runs the checks which span KEPs: that identifiers are unique and that
+// the KEP index at contentRoot agrees with the KEPs on disk. indexLocations are
+// the content locations listed in the index, nil when there is no index
+func Index(contentRoot string, indexLocations []string, metas []metadata.KEP) []Finding {
+	findings := []Finding{}
+
+	uuids := make(map[string]string)
+	shortIDs := make(map[int]string)
+
+	for _, meta := range metas {
+		path := filepath.Join(meta.ContentDir(), metadataFilename)
+
+		if meta.UniqueID() != "" {
+			if other, found := uuids[meta.UniqueID()]; found {
+				findings = append(findings, Finding{Path: path, Rule: UniqueUUIDRule, Severity: check.Error, Message: fmt.Sprintf("UUID: %s already used by: %s", meta.UniqueID(), other)})
+			} else {
+				uuids[meta.UniqueID()] = path
+			}
+		}
+
+		if meta.ShortID() != metadata.UnsetShortID {
+			if other, found := shortIDs[meta.ShortID()]; found {
+				findings = append(findings, Finding{Path: path, Rule: UniqueShortIDRule, Severity: check.Error, Message: fmt.Sprintf("short ID: %d already used by: %s", meta.ShortID(), other)})
+			} else {
+				shortIDs[meta.ShortID()] = path
+			}
+		}
+	}
+
+	if indexLocations == nil {
+		return findings
+	}
+
+	indexPath := filepath.Join(contentRoot, indexFilename)
+	indexed := make(map[string]bool)
+	for _, loc := range indexLocations {
+		indexed[filepath.Clean(loc)] = true
+
+		if _, err := metadata.Open(loc); err != nil {
+			findings = append(findings, Finding{Path: indexPath, Rule: IndexLocationRule, Severity: check.Error, Message: fmt.Sprintf("no KEP found at indexed location: %s", loc)})
+		}
+	}
+
+	for _, meta := range metas {
+		switch meta.State() {
+		case states.Implementable, states.Implemented:
+		default:
+			continue // only implementable and implemented KEPs are indexed
+		}
+
+		if !indexed[filepath.Clean(meta.ContentDir())] {
+			findings = append(findings, Finding{Path: filepath.Join(meta.ContentDir(), metadataFilename), Rule: IndexedRule, Severity: check.Warning, Message: fmt.Sprintf("%s KEP not found in index: %s", meta.State(), indexPath)})
+		}
+	}
+
+	return findings
+}
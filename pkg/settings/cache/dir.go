@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const (
+	Dirname = "kep"
+)
+
+// Dir returns the location where KEP tooling may keep data which can be
+// safely regenerated (e.g. Git repository file locks). The user cache
+// directory is preferred, falling back to the system temp directory
+func Dir() string {
+	d, err := os.UserCacheDir()
+	if err != nil || d == "" {
+		d = os.TempDir()
+	}
+
+	return filepath.Join(d, Dirname, "cache")
+}
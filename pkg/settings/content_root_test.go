@@ -24,7 +24,7 @@ var _ = Describe("finding KEP content", func() {
 			Expect(err).ToNot(HaveOccurred())
 			defer os.Unsetenv(settings.ContentRootEnv)
 
-			foundRoot, err := settings.FindContentRoot()
+			foundRoot, err := settings.FindContentRoot(&settings.User{})
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(foundRoot).To(Equal(contentDir))
@@ -42,10 +42,13 @@ var _ = Describe("finding KEP content", func() {
 			err = os.Setenv(cacheEnv, contentDir)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = settings.SaveContentRoot(contentDir)
+			err = settings.SaveContentRoot(&settings.User{}, contentDir)
 			Expect(err).ToNot(HaveOccurred())
 
-			foundRoot, err := settings.FindContentRoot()
+			userSettings, err := settings.ReadUser()
+			Expect(err).ToNot(HaveOccurred())
+
+			foundRoot, err := settings.FindContentRoot(userSettings)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(foundRoot).To(Equal(contentDir))
@@ -76,7 +79,10 @@ var _ = Describe("finding KEP content", func() {
 			Expect(err).ToNot(HaveOccurred())
 			defer os.Unsetenv(cacheEnv)
 
-			foundRoot, err := settings.FindContentRoot()
+			userSettings, err := settings.ReadUser()
+			Expect(err).ToNot(HaveOccurred(), "expected a missing user settings file to be treated as empty settings")
+
+			foundRoot, err := settings.FindContentRoot(userSettings)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(foundRoot).To(Equal(contentDir))
 		})
@@ -102,7 +108,7 @@ var _ = Describe("finding KEP content", func() {
 			Expect(err).ToNot(HaveOccurred())
 			defer os.Chdir(pwd)
 
-			_, err = settings.FindContentRoot()
+			_, err = settings.FindContentRoot(&settings.User{})
 			Expect(err.Error()).To(ContainSubstring("could not find KEP content"))
 		})
 	})
@@ -124,10 +130,13 @@ var _ = Describe("finding KEP content", func() {
 			err = createSIGDirsAt(tempDir)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = settings.SaveContentRoot(tempDir)
+			err = settings.SaveContentRoot(&settings.User{}, tempDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			userSettings, err := settings.ReadUser()
 			Expect(err).ToNot(HaveOccurred())
 
-			savedPath, err := settings.FindContentRoot()
+			savedPath, err := settings.FindContentRoot(userSettings)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(savedPath).To(Equal(tempDir))
 		})
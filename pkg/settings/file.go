@@ -40,10 +40,35 @@ func findSettingsFile() (string, error) {
 	return settingsFileLocation, nil
 }
 
+// ReadUser returns the user settings, which are empty when there is no user
+// settings file. The settings are read once by a command and passed to each
+// of the Find* and Load* functions needing them
+func ReadUser() (*User, error) {
+	u := &User{}
+
+	settingsFileLocation, err := findSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+
+	if settingsFileLocation == "" {
+		return u, nil
+	}
+
+	err = readSettingsFile(settingsFileLocation, u)
+	switch {
+	case os.IsNotExist(err):
+		return u, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return u, nil
+}
+
 func readSettingsFile(loc string, u *User) error {
 	settingsBytes, err := ioutil.ReadFile(loc)
 	if err != nil {
-		log.Errorf("reading settings location %s: %s", loc, err)
 		return err
 	}
 
@@ -0,0 +1,75 @@
+package settings
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/calebamiles/keps/pkg/changes"
+	"github.com/calebamiles/keps/pkg/changes/git"
+)
+
+// Commit signing formats which may be chosen with User.SigningFormat
+const (
+	SigningFormatGPG = "gpg" // User.SigningKey is an armored OpenPGP private key
+	SigningFormatSSH = "ssh" // User.SigningKey is an SSH key usable by `ssh-keygen -Y sign`
+)
+
+// FindAuthor returns the identity to commit changes as on behalf of principal. The
+// name and email are read from the user settings, falling back to
+// `git config user.name` and `git config user.email`, and finally to the
+// principal's GitHub handle and no-reply email address. Co-authors listed in
+// the user settings are credited on every commit
+func FindAuthor(s *User, principal string) (changes.Author, error) {
+	var err error
+
+	author := changes.Author{
+		Name:      s.Name,
+		Email:     s.Email,
+		SignOff:   s.SignOff,
+		CoAuthors: s.CoAuthors,
+	}
+
+	if author.Name == "" {
+		author.Name = gitConfig("user.name")
+	}
+
+	if author.Email == "" {
+		author.Email = gitConfig("user.email")
+	}
+
+	handle := strings.TrimPrefix(principal, "@")
+	if author.Name == "" {
+		author.Name = handle
+	}
+
+	if author.Email == "" {
+		author.Email = fmt.Sprintf("%s@users.noreply.github.com", handle)
+	}
+
+	switch s.SigningFormat {
+	case "":
+		return author, nil
+	case SigningFormatGPG:
+		author.Signer, err = git.NewGPGSigner(s.SigningKey)
+		if err != nil {
+			return changes.Author{}, err
+		}
+	case SigningFormatSSH:
+		author.Signer = git.NewSSHSigner(s.SigningKey)
+	default:
+		return changes.Author{}, fmt.Errorf("unknown commit signing format: %q", s.SigningFormat)
+	}
+
+	return author, nil
+}
+
+// gitConfig returns the value of key from the git configuration, or the empty string
+func gitConfig(key string) string {
+	out, err := exec.Command("git", "config", "--get", key).Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
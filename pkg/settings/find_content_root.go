@@ -18,7 +18,7 @@ const (
 // FindContentRoot looks for a location with the following structure
 // <some-containing-dir>/content/<all-known-sigs>
 // returning `content`
-func FindContentRoot() (string, error) {
+func FindContentRoot(s *User) (string, error) {
 	var foundRoot string
 
 	startLocation, err := contentSearchRoot()
@@ -27,10 +27,7 @@ func FindContentRoot() (string, error) {
 	}
 
 	envRoot := os.Getenv(ContentRootEnv)
-	cachedRoot, err := contentRoot()
-	if err != nil {
-		return "", err
-	}
+	cachedRoot := s.ContentRoot
 
 	switch {
 	case hasDirForEachSIG(envRoot):
@@ -75,22 +72,6 @@ func FindContentRoot() (string, error) {
 	return foundRoot, nil
 }
 
-func contentRoot() (string, error) {
-	settingsFileLocation, err := findSettingsFile()
-	if err != nil {
-		return "", err
-	}
-
-	s := &User{}
-	err = readSettingsFile(settingsFileLocation, s)
-	if err != nil {
-		log.Warn("reading user settings file")
-		return "", nil
-	}
-
-	return s.ContentRoot, nil
-}
-
 func hasDirForEachSIG(p string) bool {
 	knownSIGs := sigs.All()
 	for _, s := range knownSIGs {
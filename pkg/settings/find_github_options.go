@@ -0,0 +1,21 @@
+package settings
+
+import (
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+// FindGitHubOptions returns the location of GitHub, preferring the environment
+// (see github.ApiUrlEnv and github.GitUrlEnv) over the user settings. Unset
+// locations refer to public GitHub
+func FindGitHubOptions(s *User) (github.Options, error) {
+	opts := github.DefaultOptions()
+	if opts.ApiUrl == "" {
+		opts.ApiUrl = s.GitHubApiUrl
+	}
+
+	if opts.GitUrl == "" {
+		opts.GitUrl = s.GitHubUrl
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,32 @@
+package settings
+
+import (
+	"fmt"
+
+	"github.com/calebamiles/keps/pkg/changes"
+)
+
+// Workflows which may be chosen with User.Workflow
+const (
+	WorkflowGitHub = "github" // propose changes as GitHub pull requests (the default)
+	WorkflowLocal  = "local"  // commit changes locally and submit them as a patch series
+)
+
+// FindLocalOptions returns how changes should be submitted without GitHub, or nil
+// when the user settings do not choose WorkflowLocal
+func FindLocalOptions(s *User) (*changes.LocalOptions, error) {
+	switch s.Workflow {
+	case "", WorkflowGitHub:
+		return nil, nil
+	case WorkflowLocal:
+		opts := &changes.LocalOptions{
+			Base:     s.PatchBase,
+			PatchDir: s.PatchDir,
+			PushTo:   s.ReviewPushUrl,
+		}
+
+		return opts, nil
+	default:
+		return nil, fmt.Errorf("unknown workflow: %q", s.Workflow)
+	}
+}
@@ -1,7 +1,6 @@
 package settings
 
 import (
-	log "github.com/sirupsen/logrus"
 	"os"
 )
 
@@ -9,27 +8,13 @@ const (
 	PrincipalEnv = "KEP_PRINCIPAL_GITHUB_HANDLE"
 )
 
-func FindPrincipal() (string, error) {
+// FindPrincipal returns the principal's GitHub handle from the environment,
+// falling back to the user settings
+func FindPrincipal(s *User) (string, error) {
 	envPrincipal := os.Getenv(PrincipalEnv)
 	if envPrincipal != "" {
 		return envPrincipal, nil
 	}
 
-	return principal()
-}
-
-func principal() (string, error) {
-	settingsFileLocation, err := findSettingsFile()
-        if err != nil {
-                return "", err
-        }
-
-        s := &User{}
-        err = readSettingsFile(settingsFileLocation, s)
-        if err != nil {
-                log.Warn("reading user settings file")
-                return "", nil
-        }
-
 	return s.GitHubHandle, nil
 }
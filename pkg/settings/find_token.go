@@ -0,0 +1,127 @@
+package settings
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/calebamiles/keps/pkg/changes/auth"
+	"github.com/calebamiles/keps/pkg/changes/github"
+)
+
+const (
+	TokenPathEnv = "KEP_GITHUB_TOKEN_PATH"
+)
+
+// Token sources which may be chosen with User.GitHubTokenSource. Choosing
+// auto tries each source in the order: env, file, gh, git-credential
+const (
+	TokenSourceAuto          = "auto"           // the first source holding a token
+	TokenSourceEnv           = "env"            // GITHUB_TOKEN
+	TokenSourceFile          = "file"           // User.GitHubTokenPath or KEP_GITHUB_TOKEN_PATH
+	TokenSourceGhCli         = "gh"             // the `gh` CLI hosts file
+	TokenSourceGitCredential = "git-credential" // `git credential fill`
+	TokenSourceGitHubApp     = "github-app"     // installation tokens for User.GitHubApp*
+)
+
+// FindToken returns a provider for the principal's GitHub token from the
+// source chosen in the user settings. When no source was chosen only a
+// configured token path is used, otherwise a nil provider is returned and
+// changes are made locally rather than submitted to GitHub. Choosing auto
+// searches each source the first time a Runtime's Token() is needed, which
+// is nil when no token could be found
+func FindToken(s *User) (auth.TokenProvider, error) {
+	tokenPath := os.Getenv(TokenPathEnv)
+	if tokenPath == "" {
+		tokenPath = s.GitHubTokenPath
+	}
+
+	githubOptions, err := FindGitHubOptions(s)
+	if err != nil {
+		return nil, err
+	}
+
+	host := auth.DefaultGitHubHost
+	if u, err := url.Parse(githubOptions.GitUrl); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	switch s.GitHubTokenSource {
+	case TokenSourceEnv:
+		return auth.NewProvideTokenFromEnv(auth.GitHubTokenEnv), nil
+	case TokenSourceFile:
+		if tokenPath == "" {
+			return nil, fmt.Errorf("GitHub token source %q chosen but no token path configured", TokenSourceFile)
+		}
+
+		return auth.NewProvideTokenFromPath(tokenPath)
+	case TokenSourceGhCli:
+		return auth.NewProvideTokenFromGhConfig(auth.GhConfigPath(), host), nil
+	case TokenSourceGitCredential:
+		return auth.NewProvideTokenFromGitCredentialHelper(host), nil
+	case TokenSourceGitHubApp:
+		appToken, err := github.NewAppTokenProviderFromKeyPath(s.GitHubAppID, s.GitHubAppInstallationID, s.GitHubAppPrivateKeyPath, githubOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		return appToken, nil
+	case "":
+		if tokenPath == "" {
+			return nil, nil
+		}
+
+		return auth.NewProvideTokenFromPath(tokenPath)
+	case TokenSourceAuto:
+		// look everywhere, below
+	default:
+		return nil, fmt.Errorf("unknown GitHub token source: %q", s.GitHubTokenSource)
+	}
+
+	providers := []auth.TokenProvider{auth.NewProvideTokenFromEnv(auth.GitHubTokenEnv)}
+	if tokenPath != "" {
+		fromPath, err := auth.NewProvideTokenFromPath(tokenPath)
+		if err != nil {
+			return nil, err
+		}
+
+		providers = append(providers, fromPath)
+	}
+
+	providers = append(providers,
+		auth.NewProvideTokenFromGhConfig(auth.GhConfigPath(), host),
+		auth.NewProvideTokenFromGitCredentialHelper(host),
+	)
+
+	return &searchedToken{TokenProvider: auth.NewChainedTokenProvider(providers...)}, nil
+}
+
+// searchedToken is a token which may be found in any of the token sources
+type searchedToken struct {
+	auth.TokenProvider
+
+	once  sync.Once
+	found bool
+}
+
+// resolve searches the token sources once, returning nil when no token was found
+func (t *searchedToken) resolve() auth.TokenProvider {
+	t.once.Do(func() {
+		_, err := t.Value()
+		if err != nil {
+			log.Infof("no GitHub token found, changes will only be made locally: %s", err)
+			return
+		}
+
+		t.found = true
+	})
+
+	if !t.found {
+		return nil
+	}
+
+	return t
+}
@@ -0,0 +1,51 @@
+package settings
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+// LoadSIGs loads SIG information at runtime from the sigs.yaml named by the
+// user settings or, when unset, a sigs.yaml at the root of KEP content
+// (the KEP_CONTENT_ROOT environment variable or the saved content root). The
+// SIG information compiled into the KEP tooling remains in use when neither
+// exists. The source of the SIG information in use is returned, see sigs.Source
+func LoadSIGs(s *User) (string, error) {
+	if s.SIGsYamlPath != "" {
+		err := sigs.Load(s.SIGsYamlPath)
+		if err != nil {
+			return "", err
+		}
+
+		return sigs.Source(), nil
+	}
+
+	p := inContentRoot(s, sigs.Filename)
+	if p != "" {
+		err := sigs.Load(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return sigs.Source(), nil
+}
+
+// inContentRoot returns the path to filename at the root of KEP content, the
+// KEP_CONTENT_ROOT environment variable or the saved content root, when it exists
+func inContentRoot(s *User, filename string) string {
+	for _, root := range []string{os.Getenv(ContentRootEnv), s.ContentRoot} {
+		if root == "" {
+			continue
+		}
+
+		p := filepath.Join(root, filename)
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+
+	return ""
+}
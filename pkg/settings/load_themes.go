@@ -0,0 +1,21 @@
+package settings
+
+import (
+	"github.com/calebamiles/keps/pkg/keps/themes"
+)
+
+// LoadThemes loads the development themes at runtime from a themes.yaml at the
+// root of KEP content, found as for LoadSIGs. The development themes compiled
+// into the KEP tooling remain in use when there is none. The source of the
+// development themes in use is returned, see themes.Source
+func LoadThemes(s *User) (string, error) {
+	p := inContentRoot(s, themes.Filename)
+	if p != "" {
+		err := themes.Load(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return themes.Source(), nil
+}
@@ -38,7 +38,7 @@ var _ = Describe("determining the KEP principal user", func() {
 			Expect(err).ToNot(HaveOccurred())
 			defer os.Unsetenv(settings.PrincipalEnv)
 
-			foundPrincipal, err := settings.FindPrincipal()
+			foundPrincipal, err := settings.FindPrincipal(&settings.User{})
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(foundPrincipal).To(Equal(githubHandle))
@@ -69,7 +69,10 @@ var _ = Describe("determining the KEP principal user", func() {
 			err = ioutil.WriteFile(filepath.Join(userCacheDir, settings.Dirname, settings.Filename), userSettingsBytes, os.ModePerm)
 			Expect(err).ToNot(HaveOccurred())
 
-			foundPrincipal, err := settings.FindPrincipal()
+			userSettings, err := settings.ReadUser()
+			Expect(err).ToNot(HaveOccurred())
+
+			foundPrincipal, err := settings.FindPrincipal(userSettings)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(foundPrincipal).To(Equal(githubHandle))
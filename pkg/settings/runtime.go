@@ -1,25 +1,113 @@
 package settings
 
+import (
+	"github.com/calebamiles/keps/pkg/changes"
+	"github.com/calebamiles/keps/pkg/changes/auth"
+	"github.com/calebamiles/keps/pkg/changes/github"
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
 type Runtime interface {
 	Principal() string
 	TargetDir() string
 	ContentRoot() string
+
+	// proposing changes upstream, a nil Token() signals that
+	// changes should only be made locally
+	Token() auth.TokenProvider
+	UpstreamOwner() string
+	UpstreamRepository() string
+	GitHubOptions() github.Options
+
+	// Author is the identity changes are committed as, when the
+	// zero value the principal's GitHub no-reply identity is used
+	Author() changes.Author
+
+	// LocalOptions, when not nil, signals that changes should be committed
+	// locally and submitted as a patch series rather than a pull request
+	LocalOptions() *changes.LocalOptions
+
+	// SIGOwners provides the SIG leadership and subproject OWNERS
+	// reviewers and approvers are suggested from
+	SIGOwners() sigs.Owners
 }
 
+const (
+	DefaultUpstreamOwner      = "kubernetes"
+	DefaultUpstreamRepository = "enhancements"
+)
+
 func NewRuntime(contentRoot string, targetDir string, principal string) Runtime {
+	return NewRuntimeWithToken(contentRoot, targetDir, principal, nil)
+}
+
+// NewRuntimeWithToken returns a Runtime able to propose changes to the upstream
+// enhancements repository on behalf of the principal
+func NewRuntimeWithToken(contentRoot string, targetDir string, principal string, token auth.TokenProvider) Runtime {
+	return NewRuntimeWithOptions(contentRoot, targetDir, principal, token, github.DefaultOptions(), changes.Author{})
+}
+
+// NewRuntimeWithOptions returns a Runtime able to propose changes to the upstream
+// enhancements repository hosted at the GitHub described by opts, committing
+// changes as author
+func NewRuntimeWithOptions(contentRoot string, targetDir string, principal string, token auth.TokenProvider, opts github.Options, author changes.Author) Runtime {
 	return &runtime{
-		principal:   principal,
-		targetDir:   targetDir,
-		contentRoot: contentRoot,
+		principal:          principal,
+		targetDir:          targetDir,
+		contentRoot:        contentRoot,
+		token:              token,
+		upstreamOwner:      DefaultUpstreamOwner,
+		upstreamRepository: DefaultUpstreamRepository,
+		githubOptions:      opts,
+		author:             author,
+		sigOwners:          sigs.CurrentOwners(),
+	}
+}
+
+// NewLocalRuntime returns a Runtime committing changes as author and submitting
+// them without GitHub as described by opts
+func NewLocalRuntime(contentRoot string, targetDir string, principal string, author changes.Author, opts changes.LocalOptions) Runtime {
+	return &runtime{
+		principal:          principal,
+		targetDir:          targetDir,
+		contentRoot:        contentRoot,
+		upstreamOwner:      DefaultUpstreamOwner,
+		upstreamRepository: DefaultUpstreamRepository,
+		githubOptions:      github.DefaultOptions(),
+		author:             author,
+		localOptions:       &opts,
+		sigOwners:          sigs.CurrentOwners(),
 	}
 }
 
 type runtime struct {
-	principal   string
-	targetDir   string
-	contentRoot string
+	principal          string
+	targetDir          string
+	contentRoot        string
+	token              auth.TokenProvider
+	upstreamOwner      string
+	upstreamRepository string
+	githubOptions      github.Options
+	author             changes.Author
+	localOptions       *changes.LocalOptions
+	sigOwners          sigs.Owners
+}
+
+func (r *runtime) Principal() string             { return r.principal }
+func (r *runtime) TargetDir() string             { return r.targetDir }
+func (r *runtime) ContentRoot() string           { return r.contentRoot }
+func (r *runtime) UpstreamOwner() string         { return r.upstreamOwner }
+func (r *runtime) UpstreamRepository() string    { return r.upstreamRepository }
+func (r *runtime) GitHubOptions() github.Options { return r.githubOptions }
+func (r *runtime) Author() changes.Author        { return r.author }
+
+func (r *runtime) Token() auth.TokenProvider {
+	if searched, ok := r.token.(*searchedToken); ok {
+		return searched.resolve()
+	}
+
+	return r.token
 }
 
-func (r *runtime) Principal() string   { return r.principal }
-func (r *runtime) TargetDir() string   { return r.targetDir }
-func (r *runtime) ContentRoot() string { return r.contentRoot }
+func (r *runtime) LocalOptions() *changes.LocalOptions { return r.localOptions }
+func (r *runtime) SIGOwners() sigs.Owners              { return r.sigOwners }
@@ -7,7 +7,9 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func SaveContentRoot(p string) error {
+// SaveContentRoot records p as the content root in the user settings, writing
+// them to the user settings file
+func SaveContentRoot(s *User, p string) error {
 	settingsFileLocation, err := findSettingsFile()
 	if err != nil {
 		return err
@@ -20,21 +22,6 @@ func SaveContentRoot(p string) error {
 		return err
 	}
 
-	s := &User{}
-	err = readSettingsFile(settingsFileLocation, s)
-	switch {
-	case os.IsNotExist(err):
-		s.ContentRoot = p
-		return writeSettingsFile(settingsFileLocation, s)
-
-	case err == nil:
-		s.ContentRoot = p
-		return writeSettingsFile(settingsFileLocation, s)
-
-	default:
-		log.Error("unexpected error saving user settings")
-		return err
-	}
-
-	return nil
+	s.ContentRoot = p
+	return writeSettingsFile(settingsFileLocation, s)
 }
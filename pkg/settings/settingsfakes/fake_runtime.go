@@ -2,12 +2,26 @@
 package settingsfakes
 
 import (
-	sync "sync"
+	"sync"
 
-	settings "github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/changes"
+	"github.com/calebamiles/keps/pkg/changes/auth"
+	"github.com/calebamiles/keps/pkg/changes/github"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/sigs"
 )
 
 type FakeRuntime struct {
+	AuthorStub        func() changes.Author
+	authorMutex       sync.RWMutex
+	authorArgsForCall []struct {
+	}
+	authorReturns struct {
+		result1 changes.Author
+	}
+	authorReturnsOnCall map[int]struct {
+		result1 changes.Author
+	}
 	ContentRootStub        func() string
 	contentRootMutex       sync.RWMutex
 	contentRootArgsForCall []struct {
@@ -18,6 +32,26 @@ type FakeRuntime struct {
 	contentRootReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GitHubOptionsStub        func() github.Options
+	gitHubOptionsMutex       sync.RWMutex
+	gitHubOptionsArgsForCall []struct {
+	}
+	gitHubOptionsReturns struct {
+		result1 github.Options
+	}
+	gitHubOptionsReturnsOnCall map[int]struct {
+		result1 github.Options
+	}
+	LocalOptionsStub        func() *changes.LocalOptions
+	localOptionsMutex       sync.RWMutex
+	localOptionsArgsForCall []struct {
+	}
+	localOptionsReturns struct {
+		result1 *changes.LocalOptions
+	}
+	localOptionsReturnsOnCall map[int]struct {
+		result1 *changes.LocalOptions
+	}
 	PrincipalStub        func() string
 	principalMutex       sync.RWMutex
 	principalArgsForCall []struct {
@@ -28,6 +62,16 @@ type FakeRuntime struct {
 	principalReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SIGOwnersStub        func() sigs.Owners
+	sIGOwnersMutex       sync.RWMutex
+	sIGOwnersArgsForCall []struct {
+	}
+	sIGOwnersReturns struct {
+		result1 sigs.Owners
+	}
+	sIGOwnersReturnsOnCall map[int]struct {
+		result1 sigs.Owners
+	}
 	TargetDirStub        func() string
 	targetDirMutex       sync.RWMutex
 	targetDirArgsForCall []struct {
@@ -38,24 +82,108 @@ type FakeRuntime struct {
 	targetDirReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TokenStub        func() auth.TokenProvider
+	tokenMutex       sync.RWMutex
+	tokenArgsForCall []struct {
+	}
+	tokenReturns struct {
+		result1 auth.TokenProvider
+	}
+	tokenReturnsOnCall map[int]struct {
+		result1 auth.TokenProvider
+	}
+	UpstreamOwnerStub        func() string
+	upstreamOwnerMutex       sync.RWMutex
+	upstreamOwnerArgsForCall []struct {
+	}
+	upstreamOwnerReturns struct {
+		result1 string
+	}
+	upstreamOwnerReturnsOnCall map[int]struct {
+		result1 string
+	}
+	UpstreamRepositoryStub        func() string
+	upstreamRepositoryMutex       sync.RWMutex
+	upstreamRepositoryArgsForCall []struct {
+	}
+	upstreamRepositoryReturns struct {
+		result1 string
+	}
+	upstreamRepositoryReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeRuntime) Author() changes.Author {
+	fake.authorMutex.Lock()
+	ret, specificReturn := fake.authorReturnsOnCall[len(fake.authorArgsForCall)]
+	fake.authorArgsForCall = append(fake.authorArgsForCall, struct {
+	}{})
+	stub := fake.AuthorStub
+	fakeReturns := fake.authorReturns
+	fake.recordInvocation("Author", []interface{}{})
+	fake.authorMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuntime) AuthorCallCount() int {
+	fake.authorMutex.RLock()
+	defer fake.authorMutex.RUnlock()
+	return len(fake.authorArgsForCall)
+}
+
+func (fake *FakeRuntime) AuthorCalls(stub func() changes.Author) {
+	fake.authorMutex.Lock()
+	defer fake.authorMutex.Unlock()
+	fake.AuthorStub = stub
+}
+
+func (fake *FakeRuntime) AuthorReturns(result1 changes.Author) {
+	fake.authorMutex.Lock()
+	defer fake.authorMutex.Unlock()
+	fake.AuthorStub = nil
+	fake.authorReturns = struct {
+		result1 changes.Author
+	}{result1}
+}
+
+func (fake *FakeRuntime) AuthorReturnsOnCall(i int, result1 changes.Author) {
+	fake.authorMutex.Lock()
+	defer fake.authorMutex.Unlock()
+	fake.AuthorStub = nil
+	if fake.authorReturnsOnCall == nil {
+		fake.authorReturnsOnCall = make(map[int]struct {
+			result1 changes.Author
+		})
+	}
+	fake.authorReturnsOnCall[i] = struct {
+		result1 changes.Author
+	}{result1}
+}
+
 func (fake *FakeRuntime) ContentRoot() string {
 	fake.contentRootMutex.Lock()
 	ret, specificReturn := fake.contentRootReturnsOnCall[len(fake.contentRootArgsForCall)]
 	fake.contentRootArgsForCall = append(fake.contentRootArgsForCall, struct {
 	}{})
+	stub := fake.ContentRootStub
+	fakeReturns := fake.contentRootReturns
 	fake.recordInvocation("ContentRoot", []interface{}{})
 	fake.contentRootMutex.Unlock()
-	if fake.ContentRootStub != nil {
-		return fake.ContentRootStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.contentRootReturns
 	return fakeReturns.result1
 }
 
@@ -65,7 +193,15 @@ func (fake *FakeRuntime) ContentRootCallCount() int {
 	return len(fake.contentRootArgsForCall)
 }
 
+func (fake *FakeRuntime) ContentRootCalls(stub func() string) {
+	fake.contentRootMutex.Lock()
+	defer fake.contentRootMutex.Unlock()
+	fake.ContentRootStub = stub
+}
+
 func (fake *FakeRuntime) ContentRootReturns(result1 string) {
+	fake.contentRootMutex.Lock()
+	defer fake.contentRootMutex.Unlock()
 	fake.ContentRootStub = nil
 	fake.contentRootReturns = struct {
 		result1 string
@@ -73,6 +209,8 @@ func (fake *FakeRuntime) ContentRootReturns(result1 string) {
 }
 
 func (fake *FakeRuntime) ContentRootReturnsOnCall(i int, result1 string) {
+	fake.contentRootMutex.Lock()
+	defer fake.contentRootMutex.Unlock()
 	fake.ContentRootStub = nil
 	if fake.contentRootReturnsOnCall == nil {
 		fake.contentRootReturnsOnCall = make(map[int]struct {
@@ -84,20 +222,127 @@ func (fake *FakeRuntime) ContentRootReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRuntime) GitHubOptions() github.Options {
+	fake.gitHubOptionsMutex.Lock()
+	ret, specificReturn := fake.gitHubOptionsReturnsOnCall[len(fake.gitHubOptionsArgsForCall)]
+	fake.gitHubOptionsArgsForCall = append(fake.gitHubOptionsArgsForCall, struct {
+	}{})
+	stub := fake.GitHubOptionsStub
+	fakeReturns := fake.gitHubOptionsReturns
+	fake.recordInvocation("GitHubOptions", []interface{}{})
+	fake.gitHubOptionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuntime) GitHubOptionsCallCount() int {
+	fake.gitHubOptionsMutex.RLock()
+	defer fake.gitHubOptionsMutex.RUnlock()
+	return len(fake.gitHubOptionsArgsForCall)
+}
+
+func (fake *FakeRuntime) GitHubOptionsCalls(stub func() github.Options) {
+	fake.gitHubOptionsMutex.Lock()
+	defer fake.gitHubOptionsMutex.Unlock()
+	fake.GitHubOptionsStub = stub
+}
+
+func (fake *FakeRuntime) GitHubOptionsReturns(result1 github.Options) {
+	fake.gitHubOptionsMutex.Lock()
+	defer fake.gitHubOptionsMutex.Unlock()
+	fake.GitHubOptionsStub = nil
+	fake.gitHubOptionsReturns = struct {
+		result1 github.Options
+	}{result1}
+}
+
+func (fake *FakeRuntime) GitHubOptionsReturnsOnCall(i int, result1 github.Options) {
+	fake.gitHubOptionsMutex.Lock()
+	defer fake.gitHubOptionsMutex.Unlock()
+	fake.GitHubOptionsStub = nil
+	if fake.gitHubOptionsReturnsOnCall == nil {
+		fake.gitHubOptionsReturnsOnCall = make(map[int]struct {
+			result1 github.Options
+		})
+	}
+	fake.gitHubOptionsReturnsOnCall[i] = struct {
+		result1 github.Options
+	}{result1}
+}
+
+func (fake *FakeRuntime) LocalOptions() *changes.LocalOptions {
+	fake.localOptionsMutex.Lock()
+	ret, specificReturn := fake.localOptionsReturnsOnCall[len(fake.localOptionsArgsForCall)]
+	fake.localOptionsArgsForCall = append(fake.localOptionsArgsForCall, struct {
+	}{})
+	stub := fake.LocalOptionsStub
+	fakeReturns := fake.localOptionsReturns
+	fake.recordInvocation("LocalOptions", []interface{}{})
+	fake.localOptionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuntime) LocalOptionsCallCount() int {
+	fake.localOptionsMutex.RLock()
+	defer fake.localOptionsMutex.RUnlock()
+	return len(fake.localOptionsArgsForCall)
+}
+
+func (fake *FakeRuntime) LocalOptionsCalls(stub func() *changes.LocalOptions) {
+	fake.localOptionsMutex.Lock()
+	defer fake.localOptionsMutex.Unlock()
+	fake.LocalOptionsStub = stub
+}
+
+func (fake *FakeRuntime) LocalOptionsReturns(result1 *changes.LocalOptions) {
+	fake.localOptionsMutex.Lock()
+	defer fake.localOptionsMutex.Unlock()
+	fake.LocalOptionsStub = nil
+	fake.localOptionsReturns = struct {
+		result1 *changes.LocalOptions
+	}{result1}
+}
+
+func (fake *FakeRuntime) LocalOptionsReturnsOnCall(i int, result1 *changes.LocalOptions) {
+	fake.localOptionsMutex.Lock()
+	defer fake.localOptionsMutex.Unlock()
+	fake.LocalOptionsStub = nil
+	if fake.localOptionsReturnsOnCall == nil {
+		fake.localOptionsReturnsOnCall = make(map[int]struct {
+			result1 *changes.LocalOptions
+		})
+	}
+	fake.localOptionsReturnsOnCall[i] = struct {
+		result1 *changes.LocalOptions
+	}{result1}
+}
+
 func (fake *FakeRuntime) Principal() string {
 	fake.principalMutex.Lock()
 	ret, specificReturn := fake.principalReturnsOnCall[len(fake.principalArgsForCall)]
 	fake.principalArgsForCall = append(fake.principalArgsForCall, struct {
 	}{})
+	stub := fake.PrincipalStub
+	fakeReturns := fake.principalReturns
 	fake.recordInvocation("Principal", []interface{}{})
 	fake.principalMutex.Unlock()
-	if fake.PrincipalStub != nil {
-		return fake.PrincipalStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.principalReturns
 	return fakeReturns.result1
 }
 
@@ -107,7 +352,15 @@ func (fake *FakeRuntime) PrincipalCallCount() int {
 	return len(fake.principalArgsForCall)
 }
 
+func (fake *FakeRuntime) PrincipalCalls(stub func() string) {
+	fake.principalMutex.Lock()
+	defer fake.principalMutex.Unlock()
+	fake.PrincipalStub = stub
+}
+
 func (fake *FakeRuntime) PrincipalReturns(result1 string) {
+	fake.principalMutex.Lock()
+	defer fake.principalMutex.Unlock()
 	fake.PrincipalStub = nil
 	fake.principalReturns = struct {
 		result1 string
@@ -115,6 +368,8 @@ func (fake *FakeRuntime) PrincipalReturns(result1 string) {
 }
 
 func (fake *FakeRuntime) PrincipalReturnsOnCall(i int, result1 string) {
+	fake.principalMutex.Lock()
+	defer fake.principalMutex.Unlock()
 	fake.PrincipalStub = nil
 	if fake.principalReturnsOnCall == nil {
 		fake.principalReturnsOnCall = make(map[int]struct {
@@ -126,20 +381,74 @@ func (fake *FakeRuntime) PrincipalReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRuntime) SIGOwners() sigs.Owners {
+	fake.sIGOwnersMutex.Lock()
+	ret, specificReturn := fake.sIGOwnersReturnsOnCall[len(fake.sIGOwnersArgsForCall)]
+	fake.sIGOwnersArgsForCall = append(fake.sIGOwnersArgsForCall, struct {
+	}{})
+	stub := fake.SIGOwnersStub
+	fakeReturns := fake.sIGOwnersReturns
+	fake.recordInvocation("SIGOwners", []interface{}{})
+	fake.sIGOwnersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuntime) SIGOwnersCallCount() int {
+	fake.sIGOwnersMutex.RLock()
+	defer fake.sIGOwnersMutex.RUnlock()
+	return len(fake.sIGOwnersArgsForCall)
+}
+
+func (fake *FakeRuntime) SIGOwnersCalls(stub func() sigs.Owners) {
+	fake.sIGOwnersMutex.Lock()
+	defer fake.sIGOwnersMutex.Unlock()
+	fake.SIGOwnersStub = stub
+}
+
+func (fake *FakeRuntime) SIGOwnersReturns(result1 sigs.Owners) {
+	fake.sIGOwnersMutex.Lock()
+	defer fake.sIGOwnersMutex.Unlock()
+	fake.SIGOwnersStub = nil
+	fake.sIGOwnersReturns = struct {
+		result1 sigs.Owners
+	}{result1}
+}
+
+func (fake *FakeRuntime) SIGOwnersReturnsOnCall(i int, result1 sigs.Owners) {
+	fake.sIGOwnersMutex.Lock()
+	defer fake.sIGOwnersMutex.Unlock()
+	fake.SIGOwnersStub = nil
+	if fake.sIGOwnersReturnsOnCall == nil {
+		fake.sIGOwnersReturnsOnCall = make(map[int]struct {
+			result1 sigs.Owners
+		})
+	}
+	fake.sIGOwnersReturnsOnCall[i] = struct {
+		result1 sigs.Owners
+	}{result1}
+}
+
 func (fake *FakeRuntime) TargetDir() string {
 	fake.targetDirMutex.Lock()
 	ret, specificReturn := fake.targetDirReturnsOnCall[len(fake.targetDirArgsForCall)]
 	fake.targetDirArgsForCall = append(fake.targetDirArgsForCall, struct {
 	}{})
+	stub := fake.TargetDirStub
+	fakeReturns := fake.targetDirReturns
 	fake.recordInvocation("TargetDir", []interface{}{})
 	fake.targetDirMutex.Unlock()
-	if fake.TargetDirStub != nil {
-		return fake.TargetDirStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.targetDirReturns
 	return fakeReturns.result1
 }
 
@@ -149,7 +458,15 @@ func (fake *FakeRuntime) TargetDirCallCount() int {
 	return len(fake.targetDirArgsForCall)
 }
 
+func (fake *FakeRuntime) TargetDirCalls(stub func() string) {
+	fake.targetDirMutex.Lock()
+	defer fake.targetDirMutex.Unlock()
+	fake.TargetDirStub = stub
+}
+
 func (fake *FakeRuntime) TargetDirReturns(result1 string) {
+	fake.targetDirMutex.Lock()
+	defer fake.targetDirMutex.Unlock()
 	fake.TargetDirStub = nil
 	fake.targetDirReturns = struct {
 		result1 string
@@ -157,6 +474,8 @@ func (fake *FakeRuntime) TargetDirReturns(result1 string) {
 }
 
 func (fake *FakeRuntime) TargetDirReturnsOnCall(i int, result1 string) {
+	fake.targetDirMutex.Lock()
+	defer fake.targetDirMutex.Unlock()
 	fake.TargetDirStub = nil
 	if fake.targetDirReturnsOnCall == nil {
 		fake.targetDirReturnsOnCall = make(map[int]struct {
@@ -168,15 +487,188 @@ func (fake *FakeRuntime) TargetDirReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRuntime) Token() auth.TokenProvider {
+	fake.tokenMutex.Lock()
+	ret, specificReturn := fake.tokenReturnsOnCall[len(fake.tokenArgsForCall)]
+	fake.tokenArgsForCall = append(fake.tokenArgsForCall, struct {
+	}{})
+	stub := fake.TokenStub
+	fakeReturns := fake.tokenReturns
+	fake.recordInvocation("Token", []interface{}{})
+	fake.tokenMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuntime) TokenCallCount() int {
+	fake.tokenMutex.RLock()
+	defer fake.tokenMutex.RUnlock()
+	return len(fake.tokenArgsForCall)
+}
+
+func (fake *FakeRuntime) TokenCalls(stub func() auth.TokenProvider) {
+	fake.tokenMutex.Lock()
+	defer fake.tokenMutex.Unlock()
+	fake.TokenStub = stub
+}
+
+func (fake *FakeRuntime) TokenReturns(result1 auth.TokenProvider) {
+	fake.tokenMutex.Lock()
+	defer fake.tokenMutex.Unlock()
+	fake.TokenStub = nil
+	fake.tokenReturns = struct {
+		result1 auth.TokenProvider
+	}{result1}
+}
+
+func (fake *FakeRuntime) TokenReturnsOnCall(i int, result1 auth.TokenProvider) {
+	fake.tokenMutex.Lock()
+	defer fake.tokenMutex.Unlock()
+	fake.TokenStub = nil
+	if fake.tokenReturnsOnCall == nil {
+		fake.tokenReturnsOnCall = make(map[int]struct {
+			result1 auth.TokenProvider
+		})
+	}
+	fake.tokenReturnsOnCall[i] = struct {
+		result1 auth.TokenProvider
+	}{result1}
+}
+
+func (fake *FakeRuntime) UpstreamOwner() string {
+	fake.upstreamOwnerMutex.Lock()
+	ret, specificReturn := fake.upstreamOwnerReturnsOnCall[len(fake.upstreamOwnerArgsForCall)]
+	fake.upstreamOwnerArgsForCall = append(fake.upstreamOwnerArgsForCall, struct {
+	}{})
+	stub := fake.UpstreamOwnerStub
+	fakeReturns := fake.upstreamOwnerReturns
+	fake.recordInvocation("UpstreamOwner", []interface{}{})
+	fake.upstreamOwnerMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuntime) UpstreamOwnerCallCount() int {
+	fake.upstreamOwnerMutex.RLock()
+	defer fake.upstreamOwnerMutex.RUnlock()
+	return len(fake.upstreamOwnerArgsForCall)
+}
+
+func (fake *FakeRuntime) UpstreamOwnerCalls(stub func() string) {
+	fake.upstreamOwnerMutex.Lock()
+	defer fake.upstreamOwnerMutex.Unlock()
+	fake.UpstreamOwnerStub = stub
+}
+
+func (fake *FakeRuntime) UpstreamOwnerReturns(result1 string) {
+	fake.upstreamOwnerMutex.Lock()
+	defer fake.upstreamOwnerMutex.Unlock()
+	fake.UpstreamOwnerStub = nil
+	fake.upstreamOwnerReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRuntime) UpstreamOwnerReturnsOnCall(i int, result1 string) {
+	fake.upstreamOwnerMutex.Lock()
+	defer fake.upstreamOwnerMutex.Unlock()
+	fake.UpstreamOwnerStub = nil
+	if fake.upstreamOwnerReturnsOnCall == nil {
+		fake.upstreamOwnerReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.upstreamOwnerReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRuntime) UpstreamRepository() string {
+	fake.upstreamRepositoryMutex.Lock()
+	ret, specificReturn := fake.upstreamRepositoryReturnsOnCall[len(fake.upstreamRepositoryArgsForCall)]
+	fake.upstreamRepositoryArgsForCall = append(fake.upstreamRepositoryArgsForCall, struct {
+	}{})
+	stub := fake.UpstreamRepositoryStub
+	fakeReturns := fake.upstreamRepositoryReturns
+	fake.recordInvocation("UpstreamRepository", []interface{}{})
+	fake.upstreamRepositoryMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuntime) UpstreamRepositoryCallCount() int {
+	fake.upstreamRepositoryMutex.RLock()
+	defer fake.upstreamRepositoryMutex.RUnlock()
+	return len(fake.upstreamRepositoryArgsForCall)
+}
+
+func (fake *FakeRuntime) UpstreamRepositoryCalls(stub func() string) {
+	fake.upstreamRepositoryMutex.Lock()
+	defer fake.upstreamRepositoryMutex.Unlock()
+	fake.UpstreamRepositoryStub = stub
+}
+
+func (fake *FakeRuntime) UpstreamRepositoryReturns(result1 string) {
+	fake.upstreamRepositoryMutex.Lock()
+	defer fake.upstreamRepositoryMutex.Unlock()
+	fake.UpstreamRepositoryStub = nil
+	fake.upstreamRepositoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRuntime) UpstreamRepositoryReturnsOnCall(i int, result1 string) {
+	fake.upstreamRepositoryMutex.Lock()
+	defer fake.upstreamRepositoryMutex.Unlock()
+	fake.UpstreamRepositoryStub = nil
+	if fake.upstreamRepositoryReturnsOnCall == nil {
+		fake.upstreamRepositoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.upstreamRepositoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeRuntime) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.authorMutex.RLock()
+	defer fake.authorMutex.RUnlock()
 	fake.contentRootMutex.RLock()
 	defer fake.contentRootMutex.RUnlock()
+	fake.gitHubOptionsMutex.RLock()
+	defer fake.gitHubOptionsMutex.RUnlock()
+	fake.localOptionsMutex.RLock()
+	defer fake.localOptionsMutex.RUnlock()
 	fake.principalMutex.RLock()
 	defer fake.principalMutex.RUnlock()
+	fake.sIGOwnersMutex.RLock()
+	defer fake.sIGOwnersMutex.RUnlock()
 	fake.targetDirMutex.RLock()
 	defer fake.targetDirMutex.RUnlock()
+	fake.tokenMutex.RLock()
+	defer fake.tokenMutex.RUnlock()
+	fake.upstreamOwnerMutex.RLock()
+	defer fake.upstreamOwnerMutex.RUnlock()
+	fake.upstreamRepositoryMutex.RLock()
+	defer fake.upstreamRepositoryMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -8,4 +8,34 @@ const (
 type User struct {
 	ContentRoot  string `yaml:"content_root"`
 	GitHubHandle string `yaml:"github_handle"`
+
+	// SIG information, see LoadSIGs
+	SIGsYamlPath string `yaml:"sigs_yaml_path,omitempty"`
+
+	// commit identity, read from git config when unset
+	Name          string `yaml:"name,omitempty"`
+	Email         string `yaml:"email,omitempty"`
+	SignOff       bool   `yaml:"sign_off,omitempty"`
+	SigningFormat string `yaml:"signing_format,omitempty"` // see SigningFormat*
+	SigningKey    string `yaml:"signing_key,omitempty"`
+
+	CoAuthors []string `yaml:"co_authors,omitempty"` // `Name <email>` of each co-author
+
+	// changes are submitted to GitHub only when a token path or source is
+	// configured, see FindToken
+	GitHubTokenPath   string `yaml:"github_token_path,omitempty"`
+	GitHubTokenSource string `yaml:"github_token_source,omitempty"` // see TokenSource*
+	GitHubApiUrl      string `yaml:"github_api_url,omitempty"`
+	GitHubUrl         string `yaml:"github_url,omitempty"`
+
+	// acting as a GitHub App, see TokenSourceGitHubApp
+	GitHubAppID             int64  `yaml:"github_app_id,omitempty"`
+	GitHubAppInstallationID int64  `yaml:"github_app_installation_id,omitempty"`
+	GitHubAppPrivateKeyPath string `yaml:"github_app_private_key_path,omitempty"`
+
+	// proposing changes without GitHub, see WorkflowLocal
+	Workflow      string `yaml:"workflow,omitempty"` // see Workflow*
+	PatchDir      string `yaml:"patch_dir,omitempty"`
+	PatchBase     string `yaml:"patch_base,omitempty"`
+	ReviewPushUrl string `yaml:"review_push_url,omitempty"`
 }
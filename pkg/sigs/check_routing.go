@@ -0,0 +1,42 @@
+package sigs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// CheckRouting returns an error unless each participating group exists and is
+// not the owning group, and each subproject belongs to the owning group or one
+// of the participating groups
+func CheckRouting(owningSIG string, participatingSIGs []string, subprojects []string) error {
+	var errs *multierror.Error
+
+	groups := []string{owningSIG}
+	for _, sig := range participatingSIGs {
+		switch {
+		case !GroupExists(sig):
+			errs = multierror.Append(errs, fmt.Errorf("invalid participating SIG: %s. Not found in SIG information from: %s", sig, Source()))
+		case sig == owningSIG:
+			errs = multierror.Append(errs, fmt.Errorf("invalid participating SIG: %s. Already the owning SIG", sig))
+		default:
+			groups = append(groups, sig)
+		}
+	}
+
+	for _, subproject := range subprojects {
+		owned := false
+		for _, group := range groups {
+			if SubprojectBelongsTo(subproject, group) {
+				owned = true
+				break
+			}
+		}
+
+		if !owned {
+			errs = multierror.Append(errs, fmt.Errorf("invalid subproject: %s. Not a subproject of %s or a participating SIG", subproject, owningSIG))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
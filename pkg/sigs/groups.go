@@ -0,0 +1,39 @@
+package sigs
+
+// GroupKind distinguishes the kinds of community groups listed in sigs.yaml
+type GroupKind string
+
+const (
+	SIGKind          GroupKind = "sig"
+	WorkingGroupKind GroupKind = "wg"
+	UserGroupKind    GroupKind = "ug"
+	CommitteeKind    GroupKind = "committee"
+)
+
+// Groups returns every SIG, working group, user group and committee, SIGs first.
+// Any group may own or participate in a KEP
+func Groups() []string {
+	return current().groupList
+}
+
+// GroupsOfKind returns each group of kind k
+func GroupsOfKind(k GroupKind) []string {
+	groups := []string{}
+	for _, g := range Groups() {
+		if KindOf(g) == k {
+			groups = append(groups, g)
+		}
+	}
+
+	return groups
+}
+
+// GroupExists returns whether g is a group of any kind
+func GroupExists(g string) bool {
+	return KindOf(g) != ""
+}
+
+// KindOf returns the kind of group g, or the empty GroupKind for unknown groups
+func KindOf(g string) GroupKind {
+	return current().groupKinds[g]
+}
@@ -0,0 +1,72 @@
+package sigs_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+var _ = Describe("groups of every kind", func() {
+	AfterEach(func() {
+		sigs.UseCompiled()
+	})
+
+	It("distinguishes SIGs, working groups, user groups and committees", func() {
+		Expect(sigs.KindOf("sig-node")).To(Equal(sigs.SIGKind))
+		Expect(sigs.KindOf("wg-policy")).To(Equal(sigs.WorkingGroupKind))
+		Expect(sigs.KindOf("ug-big-data")).To(Equal(sigs.UserGroupKind))
+		Expect(sigs.KindOf("committee-steering")).To(Equal(sigs.CommitteeKind))
+		Expect(sigs.KindOf("wg-not-real")).To(BeEmpty())
+
+		Expect(sigs.GroupExists("wg-policy")).To(BeTrue())
+		Expect(sigs.Exists("wg-policy")).To(BeFalse(), "a working group is not a SIG")
+
+		Expect(sigs.Groups()).To(ContainElement("committee-steering"))
+		Expect(sigs.GroupsOfKind(sigs.WorkingGroupKind)).To(ContainElement("wg-policy"))
+		Expect(sigs.GroupsOfKind(sigs.WorkingGroupKind)).ToNot(ContainElement("sig-node"))
+	})
+
+	It("routes KEPs owned by a working group", func() {
+		info, err := sigs.BuildRoutingFromPath("/home/user/workspace/keps/content/", "wg-policy/policy-reports")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.OwningSIG()).To(Equal("wg-policy"))
+		Expect(info.SIGWide()).To(BeTrue())
+	})
+
+	It("loads groups of every kind from a sigs.yaml", func() {
+		tmpDir, err := ioutil.TempDir("", "kep-sigs")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(tmpDir)
+
+		p := filepath.Join(tmpDir, sigs.Filename)
+		Expect(ioutil.WriteFile(p, []byte(`
+sigs:
+  - name: Node
+workinggroups:
+  - name: Data Protection
+    leadership:
+      chairs:
+        - github: a-chair
+usergroups:
+  - name: Big Data
+committees:
+  - name: Steering
+`), os.ModePerm)).To(Succeed())
+
+		Expect(sigs.Load(p)).To(Succeed())
+
+		Expect(sigs.All()).To(Equal([]string{"sig-node"}))
+		Expect(sigs.Groups()).To(Equal([]string{"sig-node", "wg-data-protection", "ug-big-data", "committee-steering"}))
+		Expect(sigs.KindOf("wg-data-protection")).To(Equal(sigs.WorkingGroupKind))
+		Expect(sigs.CurrentOwners().Chairs("wg-data-protection")).To(Equal([]string{"a-chair"}))
+
+		info, err := sigs.BuildRoutingFromPath(tmpDir, "wg-data-protection/volume-backups")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.OwningSIG()).To(Equal("wg-data-protection"))
+	})
+})
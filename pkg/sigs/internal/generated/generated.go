@@ -186,6 +186,76 @@ var InfoForPath = map[string]*PathInfo{
 		SIGWide:   true,
 	},
 
+	"wg-apply": &PathInfo{
+		OwningSIG: "wg-apply",
+		SIGWide:   true,
+	},
+
+	"wg-component-standard": &PathInfo{
+		OwningSIG: "wg-component-standard",
+		SIGWide:   true,
+	},
+
+	"wg-container-identity": &PathInfo{
+		OwningSIG: "wg-container-identity",
+		SIGWide:   true,
+	},
+
+	"wg-iot-edge": &PathInfo{
+		OwningSIG: "wg-iot-edge",
+		SIGWide:   true,
+	},
+
+	"wg-k8s-infra": &PathInfo{
+		OwningSIG: "wg-k8s-infra",
+		SIGWide:   true,
+	},
+
+	"wg-machine-learning": &PathInfo{
+		OwningSIG: "wg-machine-learning",
+		SIGWide:   true,
+	},
+
+	"wg-multitenancy": &PathInfo{
+		OwningSIG: "wg-multitenancy",
+		SIGWide:   true,
+	},
+
+	"wg-policy": &PathInfo{
+		OwningSIG: "wg-policy",
+		SIGWide:   true,
+	},
+
+	"wg-resource-management": &PathInfo{
+		OwningSIG: "wg-resource-management",
+		SIGWide:   true,
+	},
+
+	"wg-security-audit": &PathInfo{
+		OwningSIG: "wg-security-audit",
+		SIGWide:   true,
+	},
+
+	"ug-big-data": &PathInfo{
+		OwningSIG: "ug-big-data",
+		SIGWide:   true,
+	},
+
+	"committee-code-of-conduct": &PathInfo{
+		OwningSIG: "committee-code-of-conduct",
+		SIGWide:   true,
+	},
+
+	"committee-product-security": &PathInfo{
+		OwningSIG: "committee-product-security",
+		SIGWide:   true,
+	},
+
+	"committee-steering": &PathInfo{
+		OwningSIG: "committee-steering",
+		SIGWide:   true,
+	},
+
 	"sig-api-machinery/server-binaries": &PathInfo{
 		OwningSIG:  "sig-api-machinery",
 		Subproject: "server-binaries",
@@ -929,7 +999,7 @@ var SIGSet = map[string]bool{
 	"sig-windows": true,
 }
 
-// FlattedSubprojectSet is the flattened collection of SIG subprojects
+// FlattedSubprojectSet is the flattened collection of subprojects of groups of every kind
 // DO NOT EDIT BY HAND
 var FlattenedSubprojectSet = map[string]bool{
 
@@ -1204,7 +1274,7 @@ var FlattenedSubprojectSet = map[string]bool{
 	"windows-testing": true,
 }
 
-// SIGSubprojectMapping groups subprojects by their owning SIG
+// SIGSubprojectMapping groups subprojects by their owning group, of any kind
 // DO NOT EDIT BY HAND
 var SIGSubprojectMapping = map[string]map[string]bool{
 
@@ -1566,6 +1636,1073 @@ var SIGSubprojectMapping = map[string]map[string]bool{
 
 		"windows-testing": true,
 	},
+
+	"wg-apply": map[string]bool{},
+
+	"wg-component-standard": map[string]bool{},
+
+	"wg-container-identity": map[string]bool{},
+
+	"wg-iot-edge": map[string]bool{},
+
+	"wg-k8s-infra": map[string]bool{},
+
+	"wg-machine-learning": map[string]bool{},
+
+	"wg-multitenancy": map[string]bool{},
+
+	"wg-policy": map[string]bool{},
+
+	"wg-resource-management": map[string]bool{},
+
+	"wg-security-audit": map[string]bool{},
+
+	"ug-big-data": map[string]bool{},
+
+	"committee-code-of-conduct": map[string]bool{},
+
+	"committee-product-security": map[string]bool{},
+
+	"committee-steering": map[string]bool{},
+}
+
+// Leadership is the leadership of a SIG listed in sigs.yaml
+type Leadership struct {
+	Chairs         []string
+	TechnicalLeads []string
+}
+
+// SubprojectOwners are the approvers and reviewers from the OWNERS files of a subproject
+type SubprojectOwners struct {
+	Approvers []string
+	Reviewers []string
+}
+
+// LeadershipForSIG maps groups of every kind to their chairs and technical leads
+// DO NOT EDIT BY HAND
+var LeadershipForSIG = map[string]*Leadership{
+
+	"sig-api-machinery": &Leadership{
+		Chairs:         []string{"deads2k", "lavalamp"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-apps": &Leadership{
+		Chairs:         []string{"mattfarina", "prydonius", "kow3ns"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-architecture": &Leadership{
+		Chairs:         []string{"bgrant0607", "jdumars"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-auth": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-autoscaling": &Leadership{
+		Chairs:         []string{"mwielgus", "directxman12"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-aws": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-azure": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-big-data": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-cli": &Leadership{
+		Chairs:         []string{"soltysh", "seans3", "pwittrock"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-cloud-provider": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-cluster-lifecycle": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-cluster-ops": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-contributor-experience": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-docs": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-gcp": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-ibmcloud": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-instrumentation": &Leadership{
+		Chairs:         []string{"piosz", "brancz"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-multicluster": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-network": &Leadership{
+		Chairs:         []string{"thockin", "dcbw", "caseydavenport"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-node": &Leadership{
+		Chairs:         []string{"dchen1107", "derekwaynecarr"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-openstack": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-pm": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-release": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-scalability": &Leadership{
+		Chairs:         []string{"wojtek-t", "shyamjvs"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-scheduling": &Leadership{
+		Chairs:         []string{"bsalamat", "k82cn"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-service-catalog": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-storage": &Leadership{
+		Chairs:         []string{"saad-ali", "childsb"},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-testing": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-ui": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-vmware": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"sig-windows": &Leadership{
+		Chairs:         []string{"michmike", "patricklang"},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-apply": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-component-standard": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-container-identity": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-iot-edge": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-k8s-infra": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-machine-learning": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-multitenancy": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-policy": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-resource-management": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"wg-security-audit": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"ug-big-data": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"committee-code-of-conduct": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"committee-product-security": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+
+	"committee-steering": &Leadership{
+		Chairs:         []string{},
+		TechnicalLeads: []string{},
+	},
+}
+
+// OwnersForSubproject maps groups of every kind to the OWNERS of each of their subprojects
+// DO NOT EDIT BY HAND
+var OwnersForSubproject = map[string]map[string]*SubprojectOwners{
+
+	"sig-api-machinery": map[string]*SubprojectOwners{
+
+		"server-binaries": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"control-plane-features": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"universal-machinery": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"server-frameworks": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"server-crd": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"server-api-aggregation": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"server-sdk": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"idl-schema-client-pipeline": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubernetes-clients": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"yaml": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"component-base": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-apps": map[string]*SubprojectOwners{
+
+		"examples": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kompose": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"workloads-api": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"application": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-architecture": map[string]*SubprojectOwners{
+
+		"architecture-and-api-governance": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"conformance-definition": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kep-adoption-and-reviews": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"code-organization": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"klog": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"steering": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-auth": map[string]*SubprojectOwners{
+
+		"audit-logging": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"authenticators": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"authorizers": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"certificates": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"encryption-at-rest": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"node-identity-and-isolation": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"policy-management": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"service-accounts": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-autoscaling": map[string]*SubprojectOwners{
+
+		"scale-client": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-autoscaler": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"vertical-pod-autoscaler": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"horizontal-pod-autoscaler": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-proportional-vertical-autoscaler": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-proportional-autoscaler": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"addon-resizer": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-aws": map[string]*SubprojectOwners{
+
+		"cloud-provider-aws": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"aws-alb-ingress-controller": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"aws-iam-authenticator": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"aws-encryption-provider": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"aws-ebs-csi-driver": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-azure": map[string]*SubprojectOwners{
+
+		"cloud-provider-azure": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-api-provider-azure": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-big-data": map[string]*SubprojectOwners{},
+
+	"sig-cli": map[string]*SubprojectOwners{
+
+		"kubectl": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kustomize": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cli-sdk": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-cloud-provider": map[string]*SubprojectOwners{
+
+		"kubernetes-cloud-provider": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cloud-provider-alibaba-cloud": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cloud-provider-gcp": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cloud-provider-openstack": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cloud-provider-vsphere": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cloud-provider-extraction": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-cluster-lifecycle": map[string]*SubprojectOwners{
+
+		"bootkube": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-api": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-api-provider-aws": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-api-provider-digitalocean": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-api-provider-gcp": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-api-provider-openstack": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kops": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kube-aws": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kube-deploy": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kube-up": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubeadm": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubeadm-dind-cluster": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubernetes-anywhere": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubespray": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"minikube": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-cluster-ops": map[string]*SubprojectOwners{},
+
+	"sig-contributor-experience": map[string]*SubprojectOwners{
+
+		"community": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"community-management": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"github-management": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"contributors-documentation": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"devstats": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"k8s.io": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"mentoring": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"repo-infra": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-docs": map[string]*SubprojectOwners{
+
+		"reference-docs": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"website": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"website-metadata": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-gcp": map[string]*SubprojectOwners{
+
+		"gcp-compute-persistent-disk-csi-driver": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"gcp-filestore-csi-driver": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-ibmcloud": map[string]*SubprojectOwners{},
+
+	"sig-instrumentation": map[string]*SubprojectOwners{
+
+		"custom-metrics-apiserver": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"heapster": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kube-state-metrics": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"metrics-server": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"metrics": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"mutating-trace-admission-controller": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-multicluster": map[string]*SubprojectOwners{
+
+		"federation-v1": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"federation-v2": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cluster-registry": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubemci": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-network": map[string]*SubprojectOwners{
+
+		"services": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kube-dns": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"external-dns": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"ingress": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"pod-networking": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"network-policy": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-node": map[string]*SubprojectOwners{
+
+		"cri-o": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"cri-tools": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"frakti": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubelet": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"node-api": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"node-feature-discovery": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"node-problem-detector": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"rktlet": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-openstack": map[string]*SubprojectOwners{},
+
+	"sig-pm": map[string]*SubprojectOwners{
+
+		"enhancements": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-release": map[string]*SubprojectOwners{
+
+		"hyperkube": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"release-team": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"publishing-bot": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"sig-release": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-scalability": map[string]*SubprojectOwners{
+
+		"kubernetes-scalability-definition": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubernetes-scalability-governance": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubernetes-scalability-test-frameworks": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubernetes-scalability-and-performance-tests-and-validation": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kubernetes-scalability-bottlenecks-detection": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-scheduling": map[string]*SubprojectOwners{
+
+		"cluster-capacity": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"descheduler": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kube-batch": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"scheduler": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"poseidon": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-service-catalog": map[string]*SubprojectOwners{
+
+		"service-catalog": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-storage": map[string]*SubprojectOwners{
+
+		"kubernetes-csi": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"external-storage": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"git-sync": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"nfs-provisioner": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"volumes": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-testing": map[string]*SubprojectOwners{
+
+		"boskos": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"gopherage": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"gubernator": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"kind": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"prow": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"testing-commons": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+
+		"test-infra": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-ui": map[string]*SubprojectOwners{
+
+		"dashboard": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-vmware": map[string]*SubprojectOwners{
+
+		"cluster-api-provider-vsphere": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"sig-windows": map[string]*SubprojectOwners{
+
+		"windows-testing": &SubprojectOwners{
+			Approvers: []string{},
+			Reviewers: []string{},
+		},
+	},
+
+	"wg-apply": map[string]*SubprojectOwners{},
+
+	"wg-component-standard": map[string]*SubprojectOwners{},
+
+	"wg-container-identity": map[string]*SubprojectOwners{},
+
+	"wg-iot-edge": map[string]*SubprojectOwners{},
+
+	"wg-k8s-infra": map[string]*SubprojectOwners{},
+
+	"wg-machine-learning": map[string]*SubprojectOwners{},
+
+	"wg-multitenancy": map[string]*SubprojectOwners{},
+
+	"wg-policy": map[string]*SubprojectOwners{},
+
+	"wg-resource-management": map[string]*SubprojectOwners{},
+
+	"wg-security-audit": map[string]*SubprojectOwners{},
+
+	"ug-big-data": map[string]*SubprojectOwners{},
+
+	"committee-code-of-conduct": map[string]*SubprojectOwners{},
+
+	"committee-product-security": map[string]*SubprojectOwners{},
+
+	"committee-steering": map[string]*SubprojectOwners{},
 }
 
 var SIGList = []string{
@@ -1632,3 +2769,40 @@ var SIGList = []string{
 
 	"sig-windows",
 }
+
+var WorkingGroupList = []string{
+
+	"wg-apply",
+
+	"wg-component-standard",
+
+	"wg-container-identity",
+
+	"wg-iot-edge",
+
+	"wg-k8s-infra",
+
+	"wg-machine-learning",
+
+	"wg-multitenancy",
+
+	"wg-policy",
+
+	"wg-resource-management",
+
+	"wg-security-audit",
+}
+
+var UserGroupList = []string{
+
+	"ug-big-data",
+}
+
+var CommitteeList = []string{
+
+	"committee-code-of-conduct",
+
+	"committee-product-security",
+
+	"committee-steering",
+}
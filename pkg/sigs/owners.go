@@ -0,0 +1,256 @@
+package sigs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+)
+
+// Owners provides the leadership of each SIG and the OWNERS of its subprojects
+// as GitHub handles
+type Owners interface {
+	Chairs(sig string) []string
+	TechnicalLeads(sig string) []string
+	SubprojectApprovers(sig string, subproject string) []string
+	SubprojectReviewers(sig string, subproject string) []string
+}
+
+// CurrentOwners returns the Owners from the SIG information in use, see Source.
+// The compiled SIG information holds the owners read when it was compiled, the
+// OWNERS files listed in a sigs.yaml loaded at runtime are read the first time
+// the owners of a subproject are needed
+func CurrentOwners() Owners {
+	return &owners{info: current}
+}
+
+type owners struct {
+	info func() *sigInfo
+}
+
+func (o *owners) Chairs(sig string) []string {
+	l := o.info().leadership[sig]
+	if l == nil {
+		return nil
+	}
+
+	return l.chairs
+}
+
+func (o *owners) TechnicalLeads(sig string) []string {
+	l := o.info().leadership[sig]
+	if l == nil {
+		return nil
+	}
+
+	return l.technicalLeads
+}
+
+func (o *owners) SubprojectApprovers(sig string, subproject string) []string {
+	return o.info().ownersOf(sig, subproject).approvers
+}
+
+func (o *owners) SubprojectReviewers(sig string, subproject string) []string {
+	return o.info().ownersOf(sig, subproject).reviewers
+}
+
+// leadership is the leadership of a group listed in sigs.yaml
+type leadership struct {
+	chairs         []string
+	technicalLeads []string
+}
+
+// subprojectOwners are the approvers and reviewers from the OWNERS files of a subproject
+type subprojectOwners struct {
+	approvers []string
+	reviewers []string
+}
+
+// ownersFiles are the OWNERS files of each subproject of each group, read on
+// demand and cached along with the OWNERS_ALIASES of their repositories
+type ownersFiles struct {
+	locker    sync.Mutex
+	locations map[string]map[string][]string
+	owners    map[string]map[string]*subprojectOwners
+	aliases   map[string]map[string][]string
+}
+
+func newOwnersFiles() *ownersFiles {
+	return &ownersFiles{
+		locations: make(map[string]map[string][]string),
+		owners:    make(map[string]map[string]*subprojectOwners),
+		aliases:   make(map[string]map[string][]string),
+	}
+}
+
+// ownersOf returns the owners of subproject of group, reading its OWNERS files
+// the first time. OWNERS files which cannot be read are skipped
+func (i *sigInfo) ownersOf(group string, subproject string) *subprojectOwners {
+	f := i.ownersFiles
+	if f == nil {
+		return &subprojectOwners{}
+	}
+
+	f.locker.Lock()
+	defer f.locker.Unlock()
+
+	if o := f.owners[group][subproject]; o != nil {
+		return o
+	}
+
+	o := &subprojectOwners{}
+	for _, location := range f.locations[group][subproject] {
+		parsed, err := f.read(location)
+		if err != nil {
+			log.Warnf("skipping OWNERS file: %s. %s", location, err)
+			continue
+		}
+
+		o.approvers = append(o.approvers, parsed.Approvers...)
+		o.reviewers = append(o.reviewers, parsed.Reviewers...)
+	}
+
+	if f.owners[group] == nil {
+		f.owners[group] = make(map[string]*subprojectOwners)
+	}
+
+	f.owners[group][subproject] = o
+
+	return o
+}
+
+// ownersFile is the subset of an OWNERS file used by KEP tooling
+type ownersFile struct {
+	Approvers []string `yaml:"approvers"`
+	Reviewers []string `yaml:"reviewers"`
+}
+
+// ownersAliasesFile is an OWNERS_ALIASES file, naming groups of people which
+// OWNERS files in the same repository may list in place of each person
+type ownersAliasesFile struct {
+	Aliases map[string][]string `yaml:"aliases"`
+}
+
+// read returns the approvers and reviewers listed in the OWNERS file at
+// location with any aliases expanded and anything else which is not a GitHub
+// handle dropped
+func (f *ownersFiles) read(location string) (*ownersFile, error) {
+	b, err := fetch(location)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := &ownersFile{}
+	err = yaml.Unmarshal(b, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := f.aliasesFor(location)
+
+	parsed.Approvers = expandAliases(parsed.Approvers, aliases)
+	parsed.Reviewers = expandAliases(parsed.Reviewers, aliases)
+
+	return parsed, nil
+}
+
+// aliasesFor returns the aliases defined in the OWNERS_ALIASES at the root of
+// the repository containing the OWNERS file at location, which is expected to
+// be a raw file URL such as https://raw.githubusercontent.com/owner/repo/ref/path/OWNERS
+func (f *ownersFiles) aliasesFor(location string) map[string][]string {
+	aliasesLocation := ownersAliasesLocation(location)
+	if aliasesLocation == "" {
+		return nil
+	}
+
+	if aliases, found := f.aliases[aliasesLocation]; found {
+		return aliases
+	}
+
+	parsed := &ownersAliasesFile{}
+
+	b, err := fetch(aliasesLocation)
+	if err == nil {
+		err = yaml.Unmarshal(b, parsed)
+	}
+
+	if err != nil {
+		log.Debugf("no OWNERS_ALIASES at: %s. %s", aliasesLocation, err)
+	}
+
+	f.aliases[aliasesLocation] = parsed.Aliases
+
+	return parsed.Aliases
+}
+
+func ownersAliasesLocation(location string) string {
+	u, err := url.Parse(location)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 4)
+	if len(parts) < 4 {
+		return ""
+	}
+
+	u.Path = "/" + strings.Join(append(parts[:3], ownersAliasesFilename), "/")
+
+	return u.String()
+}
+
+// expandAliases replaces each alias with its members, dropping entries which
+// are not GitHub handles such as aliases which could not be expanded
+func expandAliases(entries []string, aliases map[string][]string) []string {
+	expanded := []string{}
+	for _, entry := range entries {
+		members, isAlias := aliases[entry]
+		if !isAlias {
+			members = []string{entry}
+		}
+
+		for _, m := range members {
+			if !metadata.IsHandle(m) {
+				log.Debugf("skipping OWNERS entry which is not a GitHub handle: %s", m)
+				continue
+			}
+
+			expanded = append(expanded, m)
+		}
+	}
+
+	return expanded
+}
+
+var ownersClient = &http.Client{Timeout: 10 * time.Second}
+
+// fetch returns the content at location, a URL or a local path
+func fetch(location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme == "" || u.Scheme == "file" {
+		return ioutil.ReadFile(strings.TrimPrefix(location, "file://"))
+	}
+
+	resp, err := ownersClient.Get(location)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s returned %s", location, resp.Status)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+const ownersAliasesFilename = "OWNERS_ALIASES"
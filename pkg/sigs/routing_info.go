@@ -3,8 +3,7 @@ package sigs
 import (
 	"fmt"
 	"path/filepath"
-
-	"github.com/calebamiles/keps/pkg/sigs/internal/generated"
+	"strings"
 )
 
 var _ RoutingInfo = &routingInfo{}
@@ -22,14 +21,14 @@ func BuildRoutingFromPath(contentRoot string, targetPath string) (RoutingInfo, e
 	// we assume that targetPath will be of the form
 	// sig-node/kubelet/device-plugins
 	// where
-	// - sig-node/ is the owning SIG
+	// - sig-node/ is the owning SIG, which may be a group of any kind (e.g. wg-policy/)
 	// - kubelet/ is the subproject
 	// - device-plugins/ is the KEP directory
 
 	routingPath := filepath.Dir(targetPath)
 	kepDirName := filepath.Base(targetPath)
 
-	pathInfo := generated.InfoForPath[routingPath]
+	pathInfo := current().infoForPath[routingPath]
 	if pathInfo == nil {
 		return nil, fmt.Errorf("unable to determine SIG information for given path: %s", targetPath)
 	}
@@ -46,9 +45,77 @@ func BuildRoutingFromPath(contentRoot string, targetPath string) (RoutingInfo, e
 		r.AffectedSubprojectsField = []string{pathInfo.Subproject}
 	}
 
+	r.contentDir = r.contentDirFromPath()
+
+	return r, nil
+}
+
+// BuildRouting extends the routing derived from targetPath, see
+// BuildRoutingFromPath, with the groups participating in a KEP and any
+// subprojects affected in addition to the one the KEP is located in. The
+// location of the KEP is decided by targetPath alone
+func BuildRouting(contentRoot string, targetPath string, participatingSIGs []string, subprojects []string) (RoutingInfo, error) {
+	info, err := BuildRoutingFromPath(contentRoot, targetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	r := info.(*routingInfo)
+	r.ParticipatingSIGsField = appendMissing(nil, participatingSIGs)
+	r.AffectedSubprojectsField = appendMissing(r.AffectedSubprojectsField, subprojects)
+
+	err = CheckRouting(r.OwningSIGField, r.ParticipatingSIGsField, r.AffectedSubprojectsField)
+	if err != nil {
+		return nil, err
+	}
+
 	return r, nil
 }
 
+// TargetPath returns the path the KEP stored at contentDir, a directory under
+// contentRoot, would be created from: the inverse of the layout chosen by
+// BuildRoutingFromPath. For example content/sig-node/sig-wide/device-plugins
+// under content/ was created from sig-node/device-plugins
+func TargetPath(contentRoot string, contentDir string) (string, error) {
+	rel, err := filepath.Rel(contentRoot, contentDir)
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(rel, string(filepath.Separator))
+
+	switch {
+	case parts[0] == "..":
+		// contentDir is outside of contentRoot
+	case len(parts) == 2 && parts[0] == kubernetesWideDir:
+		return parts[1], nil
+	case len(parts) == 3 && parts[1] == sigWideDir:
+		return filepath.Join(parts[0], parts[2]), nil
+	case len(parts) == 3:
+		return rel, nil
+	}
+
+	return "", fmt.Errorf("location: %s is not a KEP directory in content root: %s", contentDir, contentRoot)
+}
+
+func appendMissing(existing []string, more []string) []string {
+	for _, s := range more {
+		found := false
+		for _, e := range existing {
+			if e == s {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			existing = append(existing, s)
+		}
+	}
+
+	return existing
+}
+
 const (
 	kubernetesWideDir = "kubernetes-wide"
 	sigWideDir        = "sig-wide"
@@ -62,10 +129,14 @@ type routingInfo struct {
 	SIGWideField             bool
 	contentRoot              string
 	kepDirName               string
+	contentDir               string
 }
 
-func (i *routingInfo) ContentDir() string {
-	//TODO save this info when creating the routingInfo
+func (i *routingInfo) ContentDir() string { return i.contentDir }
+
+// contentDirFromPath places KEPs without a subproject in a `kubernetes-wide` or
+// `sig-wide` directory
+func (i *routingInfo) contentDirFromPath() string {
 	switch {
 	case i.KubernetesWideField:
 		return filepath.Join(i.contentRoot, kubernetesWideDir, i.kepDirName)
@@ -1,17 +1,18 @@
 package sigs
 
-import (
-	"github.com/calebamiles/keps/pkg/sigs/internal/generated"
-)
-
 func All() []string {
-	return generated.SIGList
+	return current().sigList
 }
 
 func Exists(s string) bool {
-	return generated.SIGSet[s]
+	return current().sigSet[s]
 }
 
 func SubprojectExists(s string) bool {
-	return generated.FlattenedSubprojectSet[s]
+	return current().subprojectSet[s]
+}
+
+// SubprojectBelongsTo returns whether subproject is a subproject of group
+func SubprojectBelongsTo(subproject string, group string) bool {
+	return current().groupSubprojects[group][subproject]
 }
@@ -77,6 +77,66 @@ var _ = Describe("the SIGs helper package", func() {
 		})
 	})
 
+	Describe("BuildRouting", func() {
+		It("adds participating SIGs and affected subprojects without moving the KEP", func() {
+			contentRoot := "/home/user/workspace/keps/content/"
+			givenPath := "sig-node/kubelet/dynamic-kubelet-configuration"
+
+			info, err := sigs.BuildRouting(contentRoot, givenPath, []string{"sig-storage", "wg-policy"}, []string{"cri-tools", "kubernetes-csi", "kubelet"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(info.OwningSIG()).To(Equal("sig-node"))
+			Expect(info.ParticipatingSIGs()).To(Equal([]string{"sig-storage", "wg-policy"}))
+			Expect(info.AffectedSubprojects()).To(Equal([]string{"kubelet", "cri-tools", "kubernetes-csi"}))
+			Expect(info.ContentDir()).To(Equal("/home/user/workspace/keps/content/sig-node/kubelet/dynamic-kubelet-configuration"))
+
+			info, err = sigs.BuildRouting(contentRoot, "sig-node/kubelet-v2-api", nil, []string{"kubelet"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.ContentDir()).To(Equal("/home/user/workspace/keps/content/sig-node/sig-wide/kubelet-v2-api"))
+		})
+
+		It("rejects unknown SIGs and subprojects of SIGs not involved in the KEP", func() {
+			contentRoot := "/home/user/workspace/keps/content/"
+
+			_, err := sigs.BuildRouting(contentRoot, "sig-node/a-kep", []string{"sig-not-real"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("invalid participating SIG: sig-not-real")))
+
+			_, err = sigs.BuildRouting(contentRoot, "sig-node/a-kep", []string{"sig-node"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("Already the owning SIG")))
+
+			_, err = sigs.BuildRouting(contentRoot, "sig-node/a-kep", nil, []string{"kubernetes-csi"})
+			Expect(err).To(MatchError(ContainSubstring("invalid subproject: kubernetes-csi")))
+		})
+	})
+
+	Describe("TargetPath", func() {
+		It("returns the path the KEP would be created from", func() {
+			targetPath, err := sigs.TargetPath("/home/user/workspace/keps/content", "/home/user/workspace/keps/content/sig-node/kubelet/dynamic-kubelet-configuration/")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targetPath).To(Equal("sig-node/kubelet/dynamic-kubelet-configuration"))
+
+			targetPath, err = sigs.TargetPath("/home/user/workspace/keps/content/", "/home/user/workspace/keps/content/sig-node/sig-wide/kubelet-v2-api")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targetPath).To(Equal("sig-node/kubelet-v2-api"))
+
+			targetPath, err = sigs.TargetPath("content", "content/kubernetes-wide/kubernetes-enhancement-proposal-process")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targetPath).To(Equal("kubernetes-enhancement-proposal-process"))
+
+			info, err := sigs.BuildRoutingFromPath("content", targetPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.ContentDir()).To(Equal("content/kubernetes-wide/kubernetes-enhancement-proposal-process"))
+		})
+
+		It("returns an error for directories which are not KEP directories in the content root", func() {
+			_, err := sigs.TargetPath("/home/user/workspace/keps/content", "/home/user/elsewhere/sig-node/kubelet/dynamic-kubelet-configuration")
+			Expect(err).To(HaveOccurred())
+
+			_, err = sigs.TargetPath("/home/user/workspace/keps/content", "/home/user/workspace/keps/content/sig-node/kubelet")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 })
 
 func fetchUpstreamSIGNames() []string {
@@ -91,10 +151,10 @@ func fetchUpstreamSIGNames() []string {
 
 func fetchUpstreamSIGList() *upstreamSIGList {
 	resp, err := http.Get(upstreamSIGListURL)
-	defer resp.Body.Close()
-
 	Expect(err).ToNot(HaveOccurred(), "downloading canonical SIG list")
 
+	defer resp.Body.Close()
+
 	respBytes, err := ioutil.ReadAll(resp.Body)
 	Expect(err).ToNot(HaveOccurred(), "reading HTTP response")
 
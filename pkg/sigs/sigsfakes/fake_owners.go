@@ -0,0 +1,337 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package sigsfakes
+
+import (
+	"sync"
+
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+type FakeOwners struct {
+	ChairsStub        func(string) []string
+	chairsMutex       sync.RWMutex
+	chairsArgsForCall []struct {
+		arg1 string
+	}
+	chairsReturns struct {
+		result1 []string
+	}
+	chairsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	SubprojectApproversStub        func(string, string) []string
+	subprojectApproversMutex       sync.RWMutex
+	subprojectApproversArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	subprojectApproversReturns struct {
+		result1 []string
+	}
+	subprojectApproversReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	SubprojectReviewersStub        func(string, string) []string
+	subprojectReviewersMutex       sync.RWMutex
+	subprojectReviewersArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	subprojectReviewersReturns struct {
+		result1 []string
+	}
+	subprojectReviewersReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	TechnicalLeadsStub        func(string) []string
+	technicalLeadsMutex       sync.RWMutex
+	technicalLeadsArgsForCall []struct {
+		arg1 string
+	}
+	technicalLeadsReturns struct {
+		result1 []string
+	}
+	technicalLeadsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOwners) Chairs(arg1 string) []string {
+	fake.chairsMutex.Lock()
+	ret, specificReturn := fake.chairsReturnsOnCall[len(fake.chairsArgsForCall)]
+	fake.chairsArgsForCall = append(fake.chairsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ChairsStub
+	fakeReturns := fake.chairsReturns
+	fake.recordInvocation("Chairs", []interface{}{arg1})
+	fake.chairsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOwners) ChairsCallCount() int {
+	fake.chairsMutex.RLock()
+	defer fake.chairsMutex.RUnlock()
+	return len(fake.chairsArgsForCall)
+}
+
+func (fake *FakeOwners) ChairsCalls(stub func(string) []string) {
+	fake.chairsMutex.Lock()
+	defer fake.chairsMutex.Unlock()
+	fake.ChairsStub = stub
+}
+
+func (fake *FakeOwners) ChairsArgsForCall(i int) string {
+	fake.chairsMutex.RLock()
+	defer fake.chairsMutex.RUnlock()
+	argsForCall := fake.chairsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOwners) ChairsReturns(result1 []string) {
+	fake.chairsMutex.Lock()
+	defer fake.chairsMutex.Unlock()
+	fake.ChairsStub = nil
+	fake.chairsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) ChairsReturnsOnCall(i int, result1 []string) {
+	fake.chairsMutex.Lock()
+	defer fake.chairsMutex.Unlock()
+	fake.ChairsStub = nil
+	if fake.chairsReturnsOnCall == nil {
+		fake.chairsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.chairsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) SubprojectApprovers(arg1 string, arg2 string) []string {
+	fake.subprojectApproversMutex.Lock()
+	ret, specificReturn := fake.subprojectApproversReturnsOnCall[len(fake.subprojectApproversArgsForCall)]
+	fake.subprojectApproversArgsForCall = append(fake.subprojectApproversArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SubprojectApproversStub
+	fakeReturns := fake.subprojectApproversReturns
+	fake.recordInvocation("SubprojectApprovers", []interface{}{arg1, arg2})
+	fake.subprojectApproversMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOwners) SubprojectApproversCallCount() int {
+	fake.subprojectApproversMutex.RLock()
+	defer fake.subprojectApproversMutex.RUnlock()
+	return len(fake.subprojectApproversArgsForCall)
+}
+
+func (fake *FakeOwners) SubprojectApproversCalls(stub func(string, string) []string) {
+	fake.subprojectApproversMutex.Lock()
+	defer fake.subprojectApproversMutex.Unlock()
+	fake.SubprojectApproversStub = stub
+}
+
+func (fake *FakeOwners) SubprojectApproversArgsForCall(i int) (string, string) {
+	fake.subprojectApproversMutex.RLock()
+	defer fake.subprojectApproversMutex.RUnlock()
+	argsForCall := fake.subprojectApproversArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOwners) SubprojectApproversReturns(result1 []string) {
+	fake.subprojectApproversMutex.Lock()
+	defer fake.subprojectApproversMutex.Unlock()
+	fake.SubprojectApproversStub = nil
+	fake.subprojectApproversReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) SubprojectApproversReturnsOnCall(i int, result1 []string) {
+	fake.subprojectApproversMutex.Lock()
+	defer fake.subprojectApproversMutex.Unlock()
+	fake.SubprojectApproversStub = nil
+	if fake.subprojectApproversReturnsOnCall == nil {
+		fake.subprojectApproversReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.subprojectApproversReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) SubprojectReviewers(arg1 string, arg2 string) []string {
+	fake.subprojectReviewersMutex.Lock()
+	ret, specificReturn := fake.subprojectReviewersReturnsOnCall[len(fake.subprojectReviewersArgsForCall)]
+	fake.subprojectReviewersArgsForCall = append(fake.subprojectReviewersArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SubprojectReviewersStub
+	fakeReturns := fake.subprojectReviewersReturns
+	fake.recordInvocation("SubprojectReviewers", []interface{}{arg1, arg2})
+	fake.subprojectReviewersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOwners) SubprojectReviewersCallCount() int {
+	fake.subprojectReviewersMutex.RLock()
+	defer fake.subprojectReviewersMutex.RUnlock()
+	return len(fake.subprojectReviewersArgsForCall)
+}
+
+func (fake *FakeOwners) SubprojectReviewersCalls(stub func(string, string) []string) {
+	fake.subprojectReviewersMutex.Lock()
+	defer fake.subprojectReviewersMutex.Unlock()
+	fake.SubprojectReviewersStub = stub
+}
+
+func (fake *FakeOwners) SubprojectReviewersArgsForCall(i int) (string, string) {
+	fake.subprojectReviewersMutex.RLock()
+	defer fake.subprojectReviewersMutex.RUnlock()
+	argsForCall := fake.subprojectReviewersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOwners) SubprojectReviewersReturns(result1 []string) {
+	fake.subprojectReviewersMutex.Lock()
+	defer fake.subprojectReviewersMutex.Unlock()
+	fake.SubprojectReviewersStub = nil
+	fake.subprojectReviewersReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) SubprojectReviewersReturnsOnCall(i int, result1 []string) {
+	fake.subprojectReviewersMutex.Lock()
+	defer fake.subprojectReviewersMutex.Unlock()
+	fake.SubprojectReviewersStub = nil
+	if fake.subprojectReviewersReturnsOnCall == nil {
+		fake.subprojectReviewersReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.subprojectReviewersReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) TechnicalLeads(arg1 string) []string {
+	fake.technicalLeadsMutex.Lock()
+	ret, specificReturn := fake.technicalLeadsReturnsOnCall[len(fake.technicalLeadsArgsForCall)]
+	fake.technicalLeadsArgsForCall = append(fake.technicalLeadsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.TechnicalLeadsStub
+	fakeReturns := fake.technicalLeadsReturns
+	fake.recordInvocation("TechnicalLeads", []interface{}{arg1})
+	fake.technicalLeadsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOwners) TechnicalLeadsCallCount() int {
+	fake.technicalLeadsMutex.RLock()
+	defer fake.technicalLeadsMutex.RUnlock()
+	return len(fake.technicalLeadsArgsForCall)
+}
+
+func (fake *FakeOwners) TechnicalLeadsCalls(stub func(string) []string) {
+	fake.technicalLeadsMutex.Lock()
+	defer fake.technicalLeadsMutex.Unlock()
+	fake.TechnicalLeadsStub = stub
+}
+
+func (fake *FakeOwners) TechnicalLeadsArgsForCall(i int) string {
+	fake.technicalLeadsMutex.RLock()
+	defer fake.technicalLeadsMutex.RUnlock()
+	argsForCall := fake.technicalLeadsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOwners) TechnicalLeadsReturns(result1 []string) {
+	fake.technicalLeadsMutex.Lock()
+	defer fake.technicalLeadsMutex.Unlock()
+	fake.TechnicalLeadsStub = nil
+	fake.technicalLeadsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) TechnicalLeadsReturnsOnCall(i int, result1 []string) {
+	fake.technicalLeadsMutex.Lock()
+	defer fake.technicalLeadsMutex.Unlock()
+	fake.TechnicalLeadsStub = nil
+	if fake.technicalLeadsReturnsOnCall == nil {
+		fake.technicalLeadsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.technicalLeadsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeOwners) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.chairsMutex.RLock()
+	defer fake.chairsMutex.RUnlock()
+	fake.subprojectApproversMutex.RLock()
+	defer fake.subprojectApproversMutex.RUnlock()
+	fake.subprojectReviewersMutex.RLock()
+	defer fake.subprojectReviewersMutex.RUnlock()
+	fake.technicalLeadsMutex.RLock()
+	defer fake.technicalLeadsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOwners) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sigs.Owners = new(FakeOwners)
@@ -0,0 +1,241 @@
+package sigs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/calebamiles/keps/pkg/sigs/internal/generated"
+)
+
+const (
+	// CompiledSource is the Source of the SIG information compiled into the KEP tooling
+	CompiledSource = "compiled"
+
+	// Filename is the name of the file listing SIGs in kubernetes/community
+	Filename = "sigs.yaml"
+)
+
+// Load replaces the SIG information compiled into the KEP tooling with the SIGs
+// listed in the sigs.yaml at p, with the same semantics as the compiled tables.
+// The leadership of each SIG and the OWNERS files linked for each subproject
+// are also taken from the sigs.yaml, see CurrentOwners
+func Load(p string) error {
+	sigsBytes, err := ioutil.ReadFile(p)
+	if err != nil {
+		return err
+	}
+
+	list := &sigsYaml{}
+	err = yaml.Unmarshal(sigsBytes, list)
+	if err != nil {
+		return fmt.Errorf("parsing SIG information from %s: %s", p, err)
+	}
+
+	if len(list.SIGs) == 0 {
+		return fmt.Errorf("no SIGs listed in: %s", p)
+	}
+
+	loaded := list.info(p)
+
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	active = loaded
+
+	return nil
+}
+
+// UseCompiled discards any SIG information loaded by Load
+func UseCompiled() {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	active = compiled
+}
+
+// Source returns where the SIG information in use came from, either the path
+// given to Load or CompiledSource
+func Source() string {
+	return current().source
+}
+
+// sigInfo is the SIG information backing the exported functions of this package
+type sigInfo struct {
+	source           string
+	sigList          []string
+	sigSet           map[string]bool
+	groupList        []string // groups of every kind, SIGs first
+	groupKinds       map[string]GroupKind
+	subprojectSet    map[string]bool
+	groupSubprojects map[string]map[string]bool
+	infoForPath      map[string]*generated.PathInfo
+	leadership       map[string]*leadership
+	ownersFiles      *ownersFiles
+}
+
+var compiled = compiledInfo()
+
+func compiledInfo() *sigInfo {
+	i := &sigInfo{
+		source:           CompiledSource,
+		sigList:          generated.SIGList,
+		sigSet:           generated.SIGSet,
+		groupKinds:       make(map[string]GroupKind),
+		subprojectSet:    generated.FlattenedSubprojectSet,
+		groupSubprojects: generated.SIGSubprojectMapping,
+		infoForPath:      generated.InfoForPath,
+		leadership:       make(map[string]*leadership),
+		ownersFiles:      newOwnersFiles(),
+	}
+
+	for group, l := range generated.LeadershipForSIG {
+		i.leadership[group] = &leadership{chairs: l.Chairs, technicalLeads: l.TechnicalLeads}
+	}
+
+	// the OWNERS files of the compiled subprojects were read when compiling
+	for group, subprojects := range generated.OwnersForSubproject {
+		i.ownersFiles.owners[group] = make(map[string]*subprojectOwners)
+		for subproject, o := range subprojects {
+			i.ownersFiles.owners[group][subproject] = &subprojectOwners{approvers: o.Approvers, reviewers: o.Reviewers}
+		}
+	}
+
+	i.addGroups(SIGKind, generated.SIGList)
+	i.addGroups(WorkingGroupKind, generated.WorkingGroupList)
+	i.addGroups(UserGroupKind, generated.UserGroupList)
+	i.addGroups(CommitteeKind, generated.CommitteeList)
+
+	return i
+}
+
+func (i *sigInfo) addGroups(kind GroupKind, groups []string) {
+	for _, g := range groups {
+		i.groupList = append(i.groupList, g)
+		i.groupKinds[g] = kind
+	}
+}
+
+var (
+	activeLock sync.RWMutex
+	active     = compiled
+)
+
+func current() *sigInfo {
+	activeLock.RLock()
+	defer activeLock.RUnlock()
+
+	return active
+}
+
+// sigsYaml is the subset of kubernetes/community sigs.yaml used by KEP tooling
+type sigsYaml struct {
+	SIGs          []sigsYamlGroup `yaml:"sigs"`
+	WorkingGroups []sigsYamlGroup `yaml:"workinggroups"`
+	UserGroups    []sigsYamlGroup `yaml:"usergroups"`
+	Committees    []sigsYamlGroup `yaml:"committees"`
+}
+
+type sigsYamlGroup struct {
+	Name       string `yaml:"name"`
+	Dir        string `yaml:"dir"` // the directory of the group in kubernetes/community
+	Leadership struct {
+		Chairs         []sigsYamlLeader `yaml:"chairs"`
+		TechnicalLeads []sigsYamlLeader `yaml:"tech_leads"`
+	} `yaml:"leadership"`
+	Subprojects []struct {
+		Name   string   `yaml:"name"`
+		Owners []string `yaml:"owners"` // links to OWNERS files
+	} `yaml:"subprojects"`
+}
+
+type sigsYamlLeader struct {
+	GitHub string `yaml:"github"`
+}
+
+// info builds the same tables helpers/renderSigList compiles in
+func (l *sigsYaml) info(source string) *sigInfo {
+	i := &sigInfo{
+		source:           source,
+		sigSet:           make(map[string]bool),
+		groupKinds:       make(map[string]GroupKind),
+		subprojectSet:    make(map[string]bool),
+		groupSubprojects: make(map[string]map[string]bool),
+		infoForPath:      make(map[string]*generated.PathInfo),
+		leadership:       make(map[string]*leadership),
+		ownersFiles:      newOwnersFiles(),
+	}
+
+	i.infoForPath["."] = &generated.PathInfo{OwningSIG: "sig-architecture", KubernetesWide: true}
+	i.infoForPath[generated.ContentRoot] = &generated.PathInfo{OwningSIG: "sig-architecture", KubernetesWide: true}
+
+	for _, entry := range l.SIGs {
+		sig := entry.dir(SIGKind)
+
+		i.sigList = append(i.sigList, sig)
+		i.sigSet[sig] = true
+	}
+
+	i.addGroupEntries(SIGKind, l.SIGs)
+	i.addGroupEntries(WorkingGroupKind, l.WorkingGroups)
+	i.addGroupEntries(UserGroupKind, l.UserGroups)
+	i.addGroupEntries(CommitteeKind, l.Committees)
+
+	return i
+}
+
+func (i *sigInfo) addGroupEntries(kind GroupKind, entries []sigsYamlGroup) {
+	for _, entry := range entries {
+		group := entry.dir(kind)
+
+		i.addGroups(kind, []string{group})
+		i.infoForPath[group] = &generated.PathInfo{OwningSIG: group, SIGWide: true}
+
+		l := &leadership{}
+		for _, chair := range entry.Leadership.Chairs {
+			l.chairs = append(l.chairs, chair.GitHub)
+		}
+
+		for _, lead := range entry.Leadership.TechnicalLeads {
+			l.technicalLeads = append(l.technicalLeads, lead.GitHub)
+		}
+
+		i.leadership[group] = l
+		i.groupSubprojects[group] = make(map[string]bool)
+		i.ownersFiles.locations[group] = make(map[string][]string)
+
+		for _, sp := range entry.Subprojects {
+			subproject := canonicalName("", sp.Name)
+
+			i.subprojectSet[subproject] = true
+			i.groupSubprojects[group][subproject] = true
+			i.infoForPath[filepath.Join(group, subproject)] = &generated.PathInfo{OwningSIG: group, Subproject: subproject}
+			i.ownersFiles.locations[group][subproject] = sp.Owners
+		}
+	}
+}
+
+// dir returns the directory name of the group, derived from its name for
+// entries listed without a dir
+func (g sigsYamlGroup) dir(kind GroupKind) string {
+	if g.Dir != "" {
+		return g.Dir
+	}
+
+	return canonicalName(string(kind), g.Name)
+}
+
+// canonicalName returns the directory name of a group or subproject as named
+// in sigs.yaml, e.g. `wg-policy` for the Policy working group
+func canonicalName(prefix string, raw string) string {
+	name := strings.Replace(strings.ToLower(raw), " ", "-", -1)
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "-" + name
+}
@@ -0,0 +1,132 @@
+package sigs_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+var _ = Describe("loading SIG information at runtime", func() {
+	const sigsYaml = `
+sigs:
+  - name: Node
+    dir: sig-node
+    leadership:
+      chairs:
+        - github: dchen1107
+          name: Dawn Chen
+      tech_leads:
+        - github: derekwaynecarr
+    subprojects:
+      - name: kubelet
+        owners:
+          - https://raw.githubusercontent.com/kubernetes/kubernetes/master/pkg/kubelet/OWNERS
+  - name: Multicluster
+workinggroups:
+  - name: Long Term Support
+    dir: wg-lts
+`
+
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "kep-sigs")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		sigs.UseCompiled()
+		os.RemoveAll(tmpDir)
+	})
+
+	It("uses the SIGs listed in a sigs.yaml in place of the compiled SIGs", func() {
+		Expect(sigs.Source()).To(Equal(sigs.CompiledSource))
+
+		p := filepath.Join(tmpDir, sigs.Filename)
+		Expect(ioutil.WriteFile(p, []byte(sigsYaml), os.ModePerm)).To(Succeed())
+
+		Expect(sigs.Load(p)).To(Succeed())
+		Expect(sigs.Source()).To(Equal(p))
+
+		Expect(sigs.All()).To(Equal([]string{"sig-node", "sig-multicluster"}))
+		Expect(sigs.Exists("sig-multicluster")).To(BeTrue())
+		Expect(sigs.Exists("sig-apps")).To(BeFalse())
+		Expect(sigs.SubprojectExists("kubelet")).To(BeTrue())
+		Expect(sigs.KindOf("wg-lts")).To(Equal(sigs.WorkingGroupKind), "expected the dir listed in sigs.yaml to name the group")
+
+		owners := sigs.CurrentOwners()
+		Expect(owners.Chairs("sig-node")).To(Equal([]string{"dchen1107"}))
+		Expect(owners.TechnicalLeads("sig-node")).To(Equal([]string{"derekwaynecarr"}))
+
+		info, err := sigs.BuildRoutingFromPath(tmpDir, filepath.Join("sig-node", "kubelet", "a-kep"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.OwningSIG()).To(Equal("sig-node"))
+		Expect(info.AffectedSubprojects()).To(Equal([]string{"kubelet"}))
+
+		By("falling back to the compiled SIGs")
+		sigs.UseCompiled()
+		Expect(sigs.Source()).To(Equal(sigs.CompiledSource))
+		Expect(sigs.Exists("sig-apps")).To(BeTrue())
+	})
+
+	It("keeps the SIG information in use when a sigs.yaml cannot be loaded", func() {
+		p := filepath.Join(tmpDir, sigs.Filename)
+		Expect(ioutil.WriteFile(p, []byte("sigs: []\n"), os.ModePerm)).To(Succeed())
+
+		Expect(sigs.Load(p)).ToNot(Succeed())
+		Expect(sigs.Load(filepath.Join(tmpDir, "missing.yaml"))).ToNot(Succeed())
+		Expect(sigs.Source()).To(Equal(sigs.CompiledSource))
+	})
+
+	It("reads the OWNERS files linked from sigs.yaml when needed, expanding aliases", func() {
+		requests := map[string]int{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests[r.URL.Path]++
+
+			switch r.URL.Path {
+			case "/kubernetes/kubernetes/master/pkg/kubelet/OWNERS":
+				w.Write([]byte("approvers:\n- sig-node-approvers\n- dchen1107\nreviewers:\n- derekwaynecarr\n- not a handle\n"))
+			case "/kubernetes/kubernetes/master/OWNERS_ALIASES":
+				w.Write([]byte("aliases:\n  sig-node-approvers:\n  - mrunalp\n  - sjenning\n"))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		withOwners := strings.Replace(sigsYaml, "https://raw.githubusercontent.com", server.URL, 1)
+
+		p := filepath.Join(tmpDir, sigs.Filename)
+		Expect(ioutil.WriteFile(p, []byte(withOwners), os.ModePerm)).To(Succeed())
+		Expect(sigs.Load(p)).To(Succeed())
+
+		Expect(requests).To(BeEmpty(), "expected OWNERS files to be read only when needed")
+
+		owners := sigs.CurrentOwners()
+		Expect(owners.SubprojectApprovers("sig-node", "kubelet")).To(Equal([]string{"mrunalp", "sjenning", "dchen1107"}))
+		Expect(owners.SubprojectReviewers("sig-node", "kubelet")).To(Equal([]string{"derekwaynecarr"}))
+		Expect(owners.SubprojectApprovers("sig-multicluster", "kubefed")).To(BeEmpty())
+
+		Expect(requests["/kubernetes/kubernetes/master/pkg/kubelet/OWNERS"]).To(Equal(1))
+		Expect(requests["/kubernetes/kubernetes/master/OWNERS_ALIASES"]).To(Equal(1))
+	})
+})
+
+var _ = Describe("the compiled SIG information", func() {
+	It("lists the leadership of each SIG", func() {
+		Expect(sigs.Source()).To(Equal(sigs.CompiledSource))
+
+		owners := sigs.CurrentOwners()
+		Expect(owners.Chairs("sig-node")).ToNot(BeEmpty())
+		Expect(owners.Chairs("sig-node")).To(ContainElement("dchen1107"))
+	})
+})
@@ -22,10 +22,18 @@ import (
 // Unlike other functions in workflow/ we need to return the path explicitly as it may have
 // changed from Runtime.TargetDir() for SIG or Kubernetes wide KEPs
 func Init(runtime settings.Runtime) (string, error) {
+	return InitWithRouting(runtime, nil, nil)
+}
+
+// InitWithRouting is Init for a KEP which the participatingSIGs collaborate on
+// or which affects subprojects beyond the one it is created in. Each
+// participating SIG and subproject is validated against the known SIGs, see
+// sigs.CheckRouting
+func InitWithRouting(runtime settings.Runtime, participatingSIGs []string, subprojects []string) (string, error) {
 	authors := []string{runtime.Principal()}
 	title := buildTitleFromPath(filepath.Base(runtime.TargetDir()))
 
-	routingInfo, err := sigs.BuildRoutingFromPath(runtime.ContentRoot(), runtime.TargetDir())
+	routingInfo, err := sigs.BuildRouting(runtime.ContentRoot(), runtime.TargetDir(), participatingSIGs, subprojects)
 	if err != nil {
 		return "", err
 	}
@@ -41,7 +49,7 @@ func Init(runtime settings.Runtime) (string, error) {
 	}
 
 	// we could pass nil here but now you now the type
-	kep, err := keps.New(kepMetadata, []sections.Entry{})
+	kep, err := keps.New(runtime.ContentRoot(), kepMetadata, []sections.Entry{})
 	if err != nil {
 		//TODO erase skeleton if an error occurred
 		return "", err
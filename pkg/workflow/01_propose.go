@@ -11,31 +11,34 @@ import (
 // inspiration from https://blog.golang.org/toward-go2, Propose prepares
 // the author to explain the importance of their change through a KEP
 // Propose currently:
-//  - sets KEP state to `draft`
-// Errors returned by Propose are likely due to file i/o
-// Eventually, Propose may also handle git and GitHub operations
-func Propose(runtime settings.Runtime) error {
+//  - sets KEP state to `provisional`
+//  - when a GitHub token is configured, commits the KEP to a `<kep>-propose`
+//    branch, pushes it to the principal's fork and opens a pull request
+//    against the upstream enhancements repository
+//  - when the runtime has LocalOptions, commits the KEP to a `<kep>-propose`
+//    branch and writes the commits as a patch series
+// The pull request URL or patch series directory is returned, or the empty
+// string when the KEP was not submitted for review
+func Propose(runtime settings.Runtime) (string, error) {
 	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	kep, err := keps.Open(p)
+	kep, err := keps.Open(runtime.ContentRoot(), p)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = kep.SetState(states.Provisional)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = kep.Persist()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	// TODO add mechanics for creating PR
-
-	return nil
+	return submitForReview(runtime, kep, "Propose")
 }
@@ -11,33 +11,39 @@ import (
 // described by the introduction of a KEP.
 // Currently Accept:
 //  - adds the principal as both an approver and reviewer
+//  - adds the reviewers and approvers suggested by the owning SIG, see SuggestOwners
 //  - sets the KEP state to `provisional`
 //  - persists the KEP to disk
-func Accept(runtime settings.Runtime) error {
+//  - submits the KEP for review, see Propose
+// The review location is returned, or the empty string when the KEP was not
+// submitted for review
+func Accept(runtime settings.Runtime) (string, error) {
 	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	kep, err := keps.Open(p)
+	kep, err := keps.Open(runtime.ContentRoot(), p)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	kep.AddApprovers(runtime.Principal())
 	kep.AddReviewers(runtime.Principal())
 
+	reviewers, approvers := suggestOwners(runtime.SIGOwners(), kep)
+	kep.AddReviewers(reviewers...)
+	kep.AddApprovers(approvers...)
+
 	err = kep.SetState(states.Provisional)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = kep.Persist()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	// TODO add mechanics for creating PR
-
-	return nil
+	return submitForReview(runtime, kep, "Accept")
 }
@@ -14,29 +14,28 @@ import (
 //  - teachers: documentation writers, technical trainers, writers, all want to understand how to talk
 //              about the enhancement
 // Guide templates are rendered and their locations added to the KEP metadata. Plan also adds a template
-// for iterating on success criteria as the enhancement works towards general availability
-func Plan(runtime settings.Runtime) error {
+// for iterating on success criteria as the enhancement works towards general availability.
+// The KEP is submitted for review as by Propose, returning the review location
+func Plan(runtime settings.Runtime) (string, error) {
 	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	kep, err := keps.Open(p)
+	kep, err := keps.Open(runtime.ContentRoot(), p)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = kep.SetState(states.Implementable)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = kep.Persist()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	// TODO add mechanics for creating PR
-
-	return nil
+	return submitForReview(runtime, kep, "Plan")
 }
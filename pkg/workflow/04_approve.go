@@ -9,29 +9,28 @@ import (
 // Approve allows an approver to signal that a KEP is
 // approved for implementation. The KEP is checked for
 // consistency before the state is updated, which can
-// return an error
-func Approve(runtime settings.Runtime) error {
+// return an error. The KEP is submitted for review as
+// by Propose, returning the review location
+func Approve(runtime settings.Runtime) (string, error) {
 	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	kep, err := keps.Open(p)
+	kep, err := keps.Open(runtime.ContentRoot(), p)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = kep.SetState(states.Implementable)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = kep.Persist()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	// TODO add mechanics for creating PR
-
-	return nil
+	return submitForReview(runtime, kep, "Approve")
 }
@@ -41,10 +41,10 @@ var _ = Describe("Accept", func() {
 		// simulate targeting the newly created KEP
 		runtimeSettings.TargetDirReturns(targetDir)
 
-		err = workflow.Propose(runtimeSettings)
+		_, err = workflow.Propose(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
-		err = workflow.Accept(runtimeSettings)
+		_, err = workflow.Accept(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
 		kepMetaBytes, err := ioutil.ReadFile(filepath.Join(targetDir, metadataFilename))
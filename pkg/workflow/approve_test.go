@@ -40,21 +40,21 @@ var _ = Describe("Approve()", func() {
 		// simulate targeting the newly created KEP
 		runtimeSettings.TargetDirReturns(targetDir)
 
-		err = workflow.Propose(runtimeSettings)
+		_, err = workflow.Propose(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
-		err = workflow.Accept(runtimeSettings)
+		_, err = workflow.Accept(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
-		err = workflow.Plan(runtimeSettings)
+		_, err = workflow.Plan(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
 		By("updating the KEP state and persisting the KEP")
-		err = workflow.Approve(runtimeSettings)
+		_, err = workflow.Approve(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
 		By("marking the KEP as implementable")
-		kep, err := keps.Open(targetDir)
+		kep, err := keps.Open(tmpDir, targetDir)
 		Expect(err).ToNot(HaveOccurred(), "opening KEP after approve")
 
 		Expect(kep.State()).To(Equal(states.Implementable))
@@ -0,0 +1,85 @@
+package workflow_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	libgit "gopkg.in/src-d/go-git.v4"
+	libgitconfig "gopkg.in/src-d/go-git.v4/config"
+	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+// createGitReposAt creates a work repository with a single commit on master, a
+// bare repository used as the work repository's `origin` and a bare upstream
+// repository at kubernetes/enhancements.git containing the same commit under p
+func createGitReposAt(p string) (string, string, error) {
+	workDir := filepath.Join(p, "work")
+	originDir := filepath.Join(p, "origin.git")
+	upstreamDir := filepath.Join(p, "kubernetes", "enhancements.git")
+
+	_, err := libgit.PlainInit(originDir, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = libgit.PlainInit(upstreamDir, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	repo, err := libgit.PlainInit(workDir, false)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(workDir, "README.md"), []byte("# Enhancements\n"), os.ModePerm)
+	if err != nil {
+		return "", "", err
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = worktree.Add("README.md")
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = worktree.Commit("initial commit", &libgit.CommitOptions{
+		Author: &libgitobject.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = repo.CreateRemote(&libgitconfig.RemoteConfig{Name: "origin", URLs: []string{originDir}})
+	if err != nil {
+		return "", "", err
+	}
+
+	err = repo.Push(&libgit.PushOptions{RemoteName: "origin"})
+	if err != nil {
+		return "", "", err
+	}
+
+	_, err = repo.CreateRemote(&libgitconfig.RemoteConfig{Name: "seed-upstream", URLs: []string{upstreamDir}})
+	if err != nil {
+		return "", "", err
+	}
+
+	err = repo.Push(&libgit.PushOptions{RemoteName: "seed-upstream"})
+	if err != nil {
+		return "", "", err
+	}
+
+	err = repo.DeleteRemote("seed-upstream")
+	if err != nil {
+		return "", "", err
+	}
+
+	return workDir, originDir, nil
+}
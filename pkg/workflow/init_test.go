@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/calebamiles/keps/pkg/keps"
 	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
 
 	"github.com/calebamiles/keps/pkg/workflow"
@@ -83,6 +84,27 @@ var _ = Describe("Init", func() {
 		})
 	})
 
+	Context("when creating a KEP owned by a working group", func() {
+		It("creates a KEP in the group wide directory", func() {
+			tmpDir, err := ioutil.TempDir("", "kep-init")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpDir)
+
+			runtimeSettings := &settingsfakes.FakeRuntime{}
+			runtimeSettings.PrincipalReturns(authorOne)
+			runtimeSettings.TargetDirReturns(filepath.Join("wg-policy", "policy-reports"))
+			runtimeSettings.ContentRootReturns(tmpDir)
+
+			kepDir, err := workflow.Init(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kepDir).To(Equal(filepath.Join(tmpDir, "wg-policy", sigWideDir, "policy-reports")))
+
+			kep, err := keps.Open(tmpDir, kepDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kep.OwningSIG()).To(Equal("wg-policy"))
+		})
+	})
+
 	Context("when creating a SIG specific KEP under an existing subproject", func() {
 		It("creates a KEP skeleton at a given path", func() {
 			tmpDir, err := ioutil.TempDir("", "kep-init")
@@ -0,0 +1,149 @@
+package workflow
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/calebamiles/keps/pkg/index"
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/policy"
+	"github.com/calebamiles/keps/pkg/lint"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+// Lint checks every KEP found under paths, or under the content root when no
+// paths are given. Only KEP metadata is read so every problem is reported
+// rather than stopping at the first KEP which cannot be opened. Checks which
+// span KEPs, identifier uniqueness and agreement with the KEP index, are run
+// across all KEPs found. When fix is true routing info which does not match
+// the location of a KEP is first rewritten from the location
+func Lint(runtime settings.Runtime, paths []string, fix bool) ([]lint.Finding, error) {
+	if len(paths) == 0 {
+		paths = []string{runtime.ContentRoot()}
+	}
+
+	findings := []lint.Finding{}
+	metas := []metadata.KEP{}
+	seen := make(map[string]bool)
+
+	pol, err := policy.Load(runtime.ContentRoot())
+	if err != nil {
+		findings = append(findings, lint.UnreadablePolicy(runtime.ContentRoot(), err)...)
+		pol = &policy.Policy{}
+	}
+
+	for _, given := range paths {
+		root, err := lintRoot(runtime.ContentRoot(), given)
+		if err != nil {
+			return nil, err
+		}
+
+		err = filepath.Walk(root, func(path string, info os.FileInfo, incomingErr error) error {
+			if incomingErr != nil {
+				return incomingErr
+			}
+
+			if info.Name() != metadataFilename {
+				return nil
+			}
+
+			containingDir := filepath.Dir(path)
+			if seen[containingDir] {
+				return filepath.SkipDir
+			}
+
+			seen[containingDir] = true
+
+			meta, err := metadata.Open(containingDir)
+			if err != nil {
+				findings = append(findings, lint.Unreadable(containingDir, err)...)
+				return filepath.SkipDir
+			}
+
+			if fix {
+				findings = append(findings, fixRouting(runtime.ContentRoot(), meta)...)
+			}
+
+			findings = append(findings, lint.KEP(runtime.ContentRoot(), meta, pol)...)
+			metas = append(metas, meta)
+
+			// skip rest of directory entries because we already found the metadata
+			return filepath.SkipDir
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	indexLocations, err := index.Locations(runtime.ContentRoot())
+	if err != nil {
+		findings = append(findings, lint.UnreadableIndex(runtime.ContentRoot(), err)...)
+	}
+
+	findings = append(findings, lint.Index(runtime.ContentRoot(), indexLocations, metas)...)
+
+	return findings, nil
+}
+
+const metadataFilename = "metadata.yaml"
+
+// lintRoot resolves given as a path on disk or, failing that, relative to the
+// content root
+func lintRoot(contentRoot string, given string) (string, error) {
+	if _, err := os.Stat(given); err == nil {
+		return given, nil
+	}
+
+	p := filepath.Join(contentRoot, given)
+	_, err := os.Stat(p)
+	if err != nil {
+		return "", fmt.Errorf("nothing to lint at: %s", given)
+	}
+
+	return p, nil
+}
+
+// fixRouting rewrites the routing info of a KEP to match its location under
+// contentRoot, reporting what was done. As with Move, a previous owning SIG
+// becomes a participating SIG
+func fixRouting(contentRoot string, meta metadata.KEP) []lint.Finding {
+	if check.ThatLocationMatchesRouting(contentRoot)(meta) == nil {
+		return nil
+	}
+
+	path := filepath.Join(meta.ContentDir(), metadataFilename)
+	unfixed := func(err error) []lint.Finding {
+		return []lint.Finding{{Path: path, Rule: lint.RoutingFixedRule, Severity: check.Warning, Message: fmt.Sprintf("unable to update routing to match location: %s", err)}}
+	}
+
+	targetPath, err := sigs.TargetPath(contentRoot, meta.ContentDir())
+	if err != nil {
+		return unfixed(err)
+	}
+
+	routing, err := sigs.BuildRoutingFromPath(contentRoot, targetPath)
+	if err != nil {
+		return unfixed(err)
+	}
+
+	participatingSIGs := participatingAfterMove(meta.OwningSIG(), meta.ParticipatingSIGs(), routing.OwningSIG())
+
+	routing, err = sigs.BuildRouting(contentRoot, targetPath, participatingSIGs, meta.AffectedSubprojects())
+	if err != nil {
+		return unfixed(err)
+	}
+
+	meta.SetRouting(routing)
+	meta.AddEvent(fmt.Sprintf("routing updated to match location %s", targetPath))
+
+	err = meta.Persist()
+	if err != nil {
+		return unfixed(err)
+	}
+
+	return []lint.Finding{{Path: path, Rule: lint.RoutingFixedRule, Severity: check.Info, Message: fmt.Sprintf("routing updated to match location: %s", targetPath)}}
+}
@@ -0,0 +1,113 @@
+package workflow_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/lint"
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var _ = Describe("Lint", func() {
+	var (
+		tmpDir          string
+		kepDir          string
+		runtimeSettings *settingsfakes.FakeRuntime
+	)
+
+	BeforeEach(func() {
+		tmpDir, kepDir, runtimeSettings = initFixtureKEP(filepath.Join("sig-node", "kubelet", "device-plugins"))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("reports no problems for a KEP where it was created", func() {
+		findings, err := workflow.Lint(runtimeSettings, nil, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("reports every problem found across the content root", func() {
+		runtimeSettings.TargetDirReturns(filepath.Join("sig-node", "kubelet-v2-api"))
+		secondDir, err := workflow.Init(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred())
+
+		unreadableDir := filepath.Join(tmpDir, "sig-apps", "sig-wide", "unreadable")
+		Expect(os.MkdirAll(unreadableDir, os.ModePerm)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(unreadableDir, "metadata.yaml"), []byte("invalid..."), os.ModePerm)).To(Succeed())
+
+		firstMetadata, err := ioutil.ReadFile(filepath.Join(kepDir, "metadata.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(filepath.Join(secondDir, "metadata.yaml"), firstMetadata, os.ModePerm)).To(Succeed())
+
+		findings, err := workflow.Lint(runtimeSettings, nil, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		rules := []string{}
+		for _, f := range findings {
+			rules = append(rules, f.Rule)
+			Expect(f.Severity).To(Equal(check.Error))
+		}
+
+		Expect(rules).To(ConsistOf(
+			lint.MetadataReadableRule,
+			"location-matches-routing", // the copy claims to belong to the kubelet subproject
+			lint.UniqueUUIDRule,
+		))
+	})
+
+	It("lints only the paths given", func() {
+		findings, err := workflow.Lint(runtimeSettings, []string{filepath.Join("sig-node", "kubelet")}, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+
+		_, err = workflow.Lint(runtimeSettings, []string{"sig-not-real"}, false)
+		Expect(err).To(MatchError(ContainSubstring("nothing to lint at: sig-not-real")))
+	})
+
+	Context("when the KEP directory has been moved by hand", func() {
+		var movedDir string
+
+		BeforeEach(func() {
+			movedDir = filepath.Join(tmpDir, "sig-storage", "kubernetes-csi", "device-plugins")
+			Expect(os.MkdirAll(filepath.Dir(movedDir), os.ModePerm)).To(Succeed())
+			Expect(os.Rename(kepDir, movedDir)).To(Succeed())
+		})
+
+		It("reports that the routing info does not match the location", func() {
+			findings, err := workflow.Lint(runtimeSettings, nil, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(findings).To(HaveLen(2))
+			Expect(findings[0].Path).To(Equal(filepath.Join(movedDir, "metadata.yaml")))
+			Expect(findings[0].Rule).To(Equal("location-matches-routing"))
+			Expect(findings[0].Message).To(ContainSubstring("owning SIG: sig-node does not match location"))
+
+			_, err = keps.Open(tmpDir, movedDir)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rewrites the routing info from the location when fixing", func() {
+			findings, err := workflow.Lint(runtimeSettings, nil, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(findings).To(HaveLen(1))
+			Expect(findings[0].Rule).To(Equal(lint.RoutingFixedRule))
+			Expect(findings[0].Severity).To(Equal(check.Info))
+
+			kep, err := keps.Open(tmpDir, movedDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kep.OwningSIG()).To(Equal("sig-storage"))
+			Expect(kep.ParticipatingSIGs()).To(Equal([]string{"sig-node"}))
+			Expect(kep.AffectedSubprojects()).To(Equal([]string{"kubernetes-csi", "kubelet"}))
+		})
+	})
+})
@@ -0,0 +1,130 @@
+package workflow
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/settings"
+)
+
+// Migrated describes a KEP whose metadata was older than metadata.SchemaVersion
+type Migrated struct {
+	ContentDir string
+	Applied    []metadata.Migration
+	Diff       string // the lines of metadata.yaml removed (-) and added (+) by migrating
+}
+
+// Migrate finds every KEP under the content root whose metadata is older than
+// metadata.SchemaVersion and reports the migrations which upgrade it. Only
+// when write is true is the upgraded metadata written back to disk. KEPs which
+// cannot be migrated are reported without stopping the others
+func Migrate(runtime settings.Runtime, write bool) ([]Migrated, error) {
+	migrated := []Migrated{}
+
+	var allErrors *multierror.Error
+	err := filepath.Walk(runtime.ContentRoot(), func(path string, info os.FileInfo, incomingErr error) error {
+		if incomingErr != nil {
+			return incomingErr
+		}
+
+		if info.Name() != metadataFilename {
+			return nil
+		}
+
+		containingDir := filepath.Dir(path)
+
+		metaBytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			allErrors = multierror.Append(allErrors, err)
+			return filepath.SkipDir
+		}
+
+		upgraded, applied, err := metadata.Migrate(metaBytes)
+		if err != nil {
+			allErrors = multierror.Append(allErrors, fmt.Errorf("migrating KEP at path: %s. %s", containingDir, err))
+			return filepath.SkipDir // keep processing going
+		}
+
+		if len(applied) == 0 {
+			return filepath.SkipDir
+		}
+
+		if write {
+			err = ioutil.WriteFile(path, upgraded, os.ModePerm)
+			if err != nil {
+				allErrors = multierror.Append(allErrors, err)
+				return filepath.SkipDir
+			}
+		}
+
+		migrated = append(migrated, Migrated{
+			ContentDir: containingDir,
+			Applied:    applied,
+			Diff:       lineDiff(string(metaBytes), string(upgraded)),
+		})
+
+		// skip rest of directory entries because we already found the metadata
+		return filepath.SkipDir
+	})
+
+	allErrors = multierror.Append(allErrors, err)
+	return migrated, allErrors.ErrorOrNil()
+}
+
+// lineDiff returns the lines removed from before (-) and added in after (+),
+// in order, leaving out the lines both share
+func lineDiff(before string, after string) string {
+	a := strings.SplitAfter(before, "\n")
+	b := strings.SplitAfter(after, "\n")
+
+	// common[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				common[i][j] = common[i+1][j+1] + 1
+			case common[i+1][j] >= common[i][j+1]:
+				common[i][j] = common[i+1][j]
+			default:
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	diff := &strings.Builder{}
+	writeLine := func(prefix string, line string) {
+		if line == "" {
+			return // the empty remainder after a trailing newline
+		}
+
+		diff.WriteString(prefix + strings.TrimSuffix(line, "\n") + "\n")
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j == len(b) || (i < len(a) && common[i+1][j] >= common[i][j+1]):
+			writeLine("-", a[i])
+			i++
+		default:
+			writeLine("+", b[j])
+			j++
+		}
+	}
+
+	return diff.String()
+}
@@ -0,0 +1,99 @@
+package workflow_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var _ = Describe("Migrate", func() {
+	var (
+		tmpDir          string
+		kepDir          string
+		runtimeSettings *settingsfakes.FakeRuntime
+	)
+
+	BeforeEach(func() {
+		tmpDir, kepDir, runtimeSettings = initFixtureKEP(filepath.Join("sig-node", "kubelet", "device-plugins"))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("finds nothing to migrate in newly created KEPs", func() {
+		migrated, err := workflow.Migrate(runtimeSettings, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(migrated).To(BeEmpty())
+	})
+
+	It("only lists KEPs to migrate until asked to write them", func() {
+		p := filepath.Join(kepDir, "metadata.yaml")
+		current, err := ioutil.ReadFile(p)
+		Expect(err).ToNot(HaveOccurred())
+
+		unversioned := strings.Replace(string(current), "schema_version: 1\n", "", 1)
+		Expect(unversioned).ToNot(Equal(string(current)))
+		Expect(ioutil.WriteFile(p, []byte(unversioned), os.ModePerm)).To(Succeed())
+
+		migrated, err := workflow.Migrate(runtimeSettings, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(migrated).To(HaveLen(1))
+		Expect(migrated[0].ContentDir).To(Equal(kepDir))
+		Expect(migrated[0].Applied).To(HaveLen(1))
+		Expect(migrated[0].Diff).To(HavePrefix("+schema_version: 1\n"))
+
+		onDisk, err := ioutil.ReadFile(p)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(onDisk)).To(Equal(unversioned))
+
+		migrated, err = workflow.Migrate(runtimeSettings, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(migrated).To(HaveLen(1))
+
+		onDisk, err = ioutil.ReadFile(p)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(onDisk)).To(HavePrefix("schema_version: 1\n"))
+
+		before, err := metadata.FromBytes(current)
+		Expect(err).ToNot(HaveOccurred())
+
+		after, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after.UniqueID()).To(Equal(before.UniqueID()))
+		Expect(after.Created().Equal(before.Created())).To(BeTrue())
+		Expect(after.LastUpdated().Equal(before.LastUpdated())).To(BeTrue())
+
+		migrated, err = workflow.Migrate(runtimeSettings, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(migrated).To(BeEmpty())
+	})
+
+	It("reports KEPs which cannot be migrated without stopping", func() {
+		runtimeSettings.TargetDirReturns(filepath.Join("sig-node", "kubelet-v2-api"))
+		secondDir, err := workflow.Init(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ioutil.WriteFile(filepath.Join(secondDir, "metadata.yaml"), []byte("schema_version: 1000\n"), os.ModePerm)).To(Succeed())
+
+		p := filepath.Join(kepDir, "metadata.yaml")
+		current, err := ioutil.ReadFile(p)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(p, []byte(strings.Replace(string(current), "schema_version: 1\n", "", 1)), os.ModePerm)).To(Succeed())
+
+		migrated, err := workflow.Migrate(runtimeSettings, false)
+		Expect(err).To(MatchError(ContainSubstring("newer than the supported schema version")))
+		Expect(migrated).To(HaveLen(1))
+		Expect(migrated[0].ContentDir).To(Equal(kepDir))
+	})
+})
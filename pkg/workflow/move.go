@@ -0,0 +1,220 @@
+package workflow
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/calebamiles/keps/pkg/index"
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+// RedirectFilename is left behind in the former directory of a moved KEP
+const RedirectFilename = "README.md"
+
+// Move relocates a KEP to destination, a path of the same form given to Init
+// (e.g. sig-storage/kubernetes-csi/a-kep), returning the new KEP directory.
+// Currently Move:
+//  - renames the KEP directory
+//  - rewrites the routing info in the KEP metadata, a previous owning SIG
+//    becomes a participating SIG and affected subprojects are kept
+//  - updates the entry for the KEP in the KEP index, when one exists
+//  - records the move in the KEP event log and leaves a README pointing to
+//    the new location in the former directory
+// A KEP may be moved to a directory holding only such a README. The KEP is
+// returned to its former directory when the move fails part way
+func Move(runtime settings.Runtime, destination string) (string, error) {
+	from, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return "", err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), from)
+	if err != nil {
+		return "", err
+	}
+
+	routing, err := sigs.BuildRoutingFromPath(runtime.ContentRoot(), destination)
+	if err != nil {
+		return "", err
+	}
+
+	participatingSIGs := participatingAfterMove(kep.OwningSIG(), kep.ParticipatingSIGs(), routing.OwningSIG())
+
+	routing, err = sigs.BuildRouting(runtime.ContentRoot(), destination, participatingSIGs, kep.AffectedSubprojects())
+	if err != nil {
+		return "", err
+	}
+
+	to := routing.ContentDir()
+
+	// a KEP may be moved back to where it once was, replacing the redirect left behind
+	replacedRedirect, err := redirectAt(to)
+	if err != nil {
+		return "", err
+	}
+
+	originalMetadata, err := ioutil.ReadFile(filepath.Join(from, metadataFilename))
+	if err != nil {
+		return "", err
+	}
+
+	if replacedRedirect != nil {
+		err = os.RemoveAll(to)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(to), os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.Rename(from, to)
+	if err != nil {
+		restoreRedirect(to, replacedRedirect)
+		return "", err
+	}
+
+	err = finishMove(runtime, routing, from, to)
+	if err != nil {
+		undoMove(from, to, originalMetadata, replacedRedirect)
+		return "", err
+	}
+
+	return to, nil
+}
+
+// finishMove updates a KEP renamed from from to to so that it matches its new
+// location, leaving a redirect behind
+func finishMove(runtime settings.Runtime, routing sigs.RoutingInfo, from string, to string) error {
+	// the metadata no longer matches the location of the KEP so update it
+	// before opening the KEP, which checks that it does
+	meta, err := metadata.Open(to)
+	if err != nil {
+		return err
+	}
+
+	fromLocation := contentRelative(runtime.ContentRoot(), from)
+	toLocation := contentRelative(runtime.ContentRoot(), to)
+
+	meta.SetRouting(routing)
+	meta.AddEvent(fmt.Sprintf("moved from %s to %s", fromLocation, toLocation))
+
+	err = meta.Persist()
+	if err != nil {
+		return err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), to)
+	if err != nil {
+		return err
+	}
+
+	err = kep.Persist() // regenerate sections derived from routing info
+	if err != nil {
+		return err
+	}
+
+	err = leaveRedirect(from, kep.Title(), toLocation)
+	if err != nil {
+		return err
+	}
+
+	return index.Relocate(runtime.ContentRoot(), from, kep)
+}
+
+// undoMove returns a KEP to from after a failed move to to, along with its
+// original metadata and any redirect it replaced
+func undoMove(from string, to string, originalMetadata []byte, replacedRedirect []byte) {
+	// the former directory holds at most the redirect left behind
+	os.Remove(filepath.Join(from, RedirectFilename))
+	os.Remove(from)
+
+	err := ioutil.WriteFile(filepath.Join(to, metadataFilename), originalMetadata, os.ModePerm)
+	if err == nil {
+		err = os.Rename(to, from)
+	}
+
+	if err != nil {
+		log.Errorf("could not return KEP at %s to %s after a failed move: %s", to, from, err)
+		return
+	}
+
+	restoreRedirect(to, replacedRedirect)
+}
+
+// redirectAt returns the redirect in dir when dir only holds a redirect left
+// behind by Move, and an error when dir holds anything else
+func redirectAt(dir string) ([]byte, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) != 1 || entries[0].Name() != RedirectFilename {
+		return nil, fmt.Errorf("cannot move KEP to %s, the location already exists", dir)
+	}
+
+	return ioutil.ReadFile(filepath.Join(dir, RedirectFilename))
+}
+
+func restoreRedirect(dir string, redirect []byte) {
+	if redirect == nil {
+		return
+	}
+
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(dir, RedirectFilename), redirect, os.ModePerm)
+	}
+
+	if err != nil {
+		log.Errorf("could not restore the redirect at %s: %s", dir, err)
+	}
+}
+
+// participatingAfterMove returns the groups participating in a KEP once owned
+// by newOwner, the previous owner joins those already participating
+func participatingAfterMove(owningSIG string, participatingSIGs []string, newOwner string) []string {
+	participating := []string{}
+	for _, sig := range append([]string{owningSIG}, participatingSIGs...) {
+		if sig != "" && sig != newOwner {
+			participating = append(participating, sig)
+		}
+	}
+
+	return participating
+}
+
+func leaveRedirect(from string, title string, toLocation string) error {
+	err := os.MkdirAll(from, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	redirect := fmt.Sprintf("# %s\n\nThis KEP has moved to `%s`\n", title, toLocation)
+
+	return ioutil.WriteFile(filepath.Join(from, RedirectFilename), []byte(redirect), os.ModePerm)
+}
+
+// contentRelative returns p relative to contentRoot when possible
+func contentRelative(contentRoot string, p string) string {
+	rel, err := filepath.Rel(contentRoot, p)
+	if err != nil {
+		return p
+	}
+
+	return filepath.ToSlash(rel)
+}
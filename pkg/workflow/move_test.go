@@ -0,0 +1,135 @@
+package workflow_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/index"
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var _ = Describe("Move", func() {
+	var (
+		tmpDir          string
+		kepDir          string
+		runtimeSettings *settingsfakes.FakeRuntime
+	)
+
+	BeforeEach(func() {
+		tmpDir, kepDir, runtimeSettings = initFixtureKEP(filepath.Join("sig-node", "kubelet", "device-plugins"))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("relocates the KEP and rewrites its routing", func() {
+		indexLocation := filepath.Join(tmpDir, "keps.yaml")
+		Expect(ioutil.WriteFile(indexLocation, []byte("keps:\n- uuid: some-uuid\n  content_location: "+kepDir+"\nNEXT_KEP_NUMBER: 1\n"), os.ModePerm)).To(Succeed())
+
+		movedDir, err := workflow.Move(runtimeSettings, filepath.Join("sig-storage", "kubernetes-csi", "device-plugins"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(movedDir).To(Equal(filepath.Join(tmpDir, "sig-storage", "kubernetes-csi", "device-plugins")))
+
+		kep, err := keps.Open(tmpDir, movedDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.OwningSIG()).To(Equal("sig-storage"))
+		Expect(kep.ParticipatingSIGs()).To(Equal([]string{"sig-node"}))
+		Expect(kep.AffectedSubprojects()).To(Equal([]string{"kubernetes-csi", "kubelet"}))
+		Expect(kep.Events()).To(HaveLen(1))
+		Expect(kep.Events()[0].Description).To(Equal("moved from sig-node/kubelet/device-plugins to sig-storage/kubernetes-csi/device-plugins"))
+
+		By("updating the content location in the index")
+		indexBytes, err := ioutil.ReadFile(indexLocation)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(indexBytes)).To(ContainSubstring("content_location: " + movedDir))
+		Expect(string(indexBytes)).To(ContainSubstring("owning_sig: sig-storage"))
+		Expect(string(indexBytes)).To(ContainSubstring("uuid: " + kep.UniqueID()))
+
+		By("leaving a redirect in the former directory")
+		redirect, err := ioutil.ReadFile(filepath.Join(kepDir, workflow.RedirectFilename))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(redirect)).To(ContainSubstring("sig-storage/kubernetes-csi/device-plugins"))
+		Expect(filepath.Join(kepDir, "metadata.yaml")).ToNot(BeAnExistingFile())
+	})
+
+	It("moves a KEP within its owning SIG", func() {
+		movedDir, err := workflow.Move(runtimeSettings, filepath.Join("sig-node", "device-plugins"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(movedDir).To(Equal(filepath.Join(tmpDir, "sig-node", "sig-wide", "device-plugins")))
+
+		kep, err := keps.Open(tmpDir, movedDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.OwningSIG()).To(Equal("sig-node"))
+		Expect(kep.ParticipatingSIGs()).To(BeEmpty())
+		Expect(kep.AffectedSubprojects()).To(Equal([]string{"kubelet"}))
+	})
+
+	It("refuses to replace an existing directory", func() {
+		Expect(os.MkdirAll(filepath.Join(tmpDir, "sig-storage", "sig-wide", "device-plugins"), os.ModePerm)).To(Succeed())
+
+		_, err := workflow.Move(runtimeSettings, filepath.Join("sig-storage", "device-plugins"))
+		Expect(err).To(MatchError(ContainSubstring("already exists")))
+		Expect(filepath.Join(kepDir, "metadata.yaml")).To(BeAnExistingFile())
+	})
+
+	It("moves a KEP back to the directory it left", func() {
+		movedDir, err := workflow.Move(runtimeSettings, filepath.Join("sig-storage", "kubernetes-csi", "device-plugins"))
+		Expect(err).ToNot(HaveOccurred())
+
+		runtimeSettings.TargetDirReturns(movedDir)
+
+		returnedDir, err := workflow.Move(runtimeSettings, filepath.Join("sig-node", "kubelet", "device-plugins"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(returnedDir).To(Equal(kepDir))
+
+		kep, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.OwningSIG()).To(Equal("sig-node"))
+		Expect(filepath.Join(movedDir, workflow.RedirectFilename)).To(BeAnExistingFile())
+	})
+
+	It("keeps a single index entry when a KEP moves back over its redirect", func() {
+		kep, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		indexLocation := filepath.Join(tmpDir, "keps.yaml")
+		Expect(ioutil.WriteFile(indexLocation, []byte("keps:\n- uuid: "+kep.UniqueID()+"\n  content_location: "+kepDir+"\nNEXT_KEP_NUMBER: 1\n"), os.ModePerm)).To(Succeed())
+
+		movedDir, err := workflow.Move(runtimeSettings, filepath.Join("sig-storage", "kubernetes-csi", "device-plugins"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// an entry left behind for the redirect at the former location
+		Expect(ioutil.WriteFile(indexLocation, []byte("keps:\n- uuid: "+kep.UniqueID()+"\n  content_location: "+movedDir+"\n- content_location: "+kepDir+"\nNEXT_KEP_NUMBER: 1\n"), os.ModePerm)).To(Succeed())
+
+		runtimeSettings.TargetDirReturns(movedDir)
+
+		_, err = workflow.Move(runtimeSettings, filepath.Join("sig-node", "kubelet", "device-plugins"))
+		Expect(err).ToNot(HaveOccurred())
+
+		locations, err := index.Locations(tmpDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(locations).To(Equal([]string{kepDir}))
+	})
+
+	It("returns the KEP to its former directory when the move fails", func() {
+		indexLocation := filepath.Join(tmpDir, "keps.yaml")
+		Expect(ioutil.WriteFile(indexLocation, []byte("keps: [not an index"), os.ModePerm)).To(Succeed())
+
+		_, err := workflow.Move(runtimeSettings, filepath.Join("sig-storage", "kubernetes-csi", "device-plugins"))
+		Expect(err).To(HaveOccurred())
+
+		kep, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.OwningSIG()).To(Equal("sig-node"))
+		Expect(kep.Events()).To(BeEmpty())
+		Expect(filepath.Join(tmpDir, "sig-storage", "kubernetes-csi", "device-plugins")).ToNot(BeADirectory())
+	})
+})
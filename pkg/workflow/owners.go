@@ -0,0 +1,109 @@
+package workflow
+
+import (
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+// SuggestOwners returns the reviewers and approvers suggested for a KEP by the
+// OWNERS data of its owning SIG. Approvers are the OWNERS approvers of each
+// affected subproject, or the SIG chairs and technical leads when there are
+// none. Reviewers are the OWNERS reviewers of each affected subproject along
+// with the SIG technical leads. KEP authors are never suggested
+func SuggestOwners(runtime settings.Runtime) ([]string, []string, error) {
+	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewers, approvers := suggestOwners(runtime.SIGOwners(), kep)
+
+	return reviewers, approvers, nil
+}
+
+// AssignOwners adds the reviewers and approvers suggested by SuggestOwners to
+// the KEP and persists it, returning the reviewers and approvers added
+func AssignOwners(runtime settings.Runtime) ([]string, []string, error) {
+	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewers, approvers := suggestOwners(runtime.SIGOwners(), kep)
+
+	kep.AddReviewers(reviewers...)
+	kep.AddApprovers(approvers...)
+
+	err = kep.Persist()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reviewers, approvers, nil
+}
+
+func suggestOwners(owners sigs.Owners, kep keps.Instance) ([]string, []string) {
+	if owners == nil {
+		return nil, nil
+	}
+
+	sig := kep.OwningSIG()
+
+	approvers := newHandleSet(kep.Authors())
+	reviewers := newHandleSet(kep.Authors())
+
+	for _, subproject := range kep.AffectedSubprojects() {
+		approvers.add(owners.SubprojectApprovers(sig, subproject)...)
+		reviewers.add(owners.SubprojectReviewers(sig, subproject)...)
+	}
+
+	if len(approvers.handles) == 0 {
+		approvers.add(owners.Chairs(sig)...)
+		approvers.add(owners.TechnicalLeads(sig)...)
+	}
+
+	reviewers.add(owners.TechnicalLeads(sig)...)
+
+	return reviewers.handles, approvers.handles
+}
+
+// handleSet collects GitHub handles in the order they are added, ignoring
+// duplicates, identities without a handle, anything which is not a GitHub
+// handle (such as an OWNERS alias) and any identities excluded up front
+type handleSet struct {
+	seen    map[string]bool
+	handles []string
+}
+
+func newHandleSet(excluded []string) *handleSet {
+	s := &handleSet{seen: make(map[string]bool)}
+	for _, identity := range excluded {
+		s.seen[metadata.IdentityKey(identity)] = true
+	}
+
+	return s
+}
+
+func (s *handleSet) add(identities ...string) {
+	for _, given := range identities {
+		identity := metadata.ParseIdentity(given)
+		if !metadata.IsHandle(identity.Handle) || s.seen[identity.Key()] {
+			continue
+		}
+
+		s.seen[identity.Key()] = true
+		s.handles = append(s.handles, identity.Handle)
+	}
+}
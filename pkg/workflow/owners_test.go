@@ -0,0 +1,95 @@
+package workflow_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+	"github.com/calebamiles/keps/pkg/sigs/sigsfakes"
+
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var _ = Describe("suggesting KEP owners", func() {
+	const authorOne = "handleOne"
+
+	var (
+		tmpDir          string
+		owners          *sigsfakes.FakeOwners
+		runtimeSettings *settingsfakes.FakeRuntime
+	)
+
+	initKEP := func(targetDir string) {
+		runtimeSettings.TargetDirReturns(targetDir)
+
+		kepDir, err := workflow.Init(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred(), "simulating `kep init`")
+
+		runtimeSettings.TargetDirReturns(kepDir)
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "kep-owners")
+		Expect(err).ToNot(HaveOccurred())
+
+		owners = &sigsfakes.FakeOwners{}
+		owners.ChairsReturns([]string{"chairOne", "HandleOne"})
+		owners.TechnicalLeadsReturns([]string{"leadOne"})
+		owners.SubprojectApproversReturns([]string{"approverOne", "leadOne"})
+		owners.SubprojectReviewersReturns([]string{"@reviewerOne", "reviewerOne", "kubernetes/sig-node-reviewers"})
+
+		runtimeSettings = &settingsfakes.FakeRuntime{}
+		runtimeSettings.PrincipalReturns(authorOne)
+		runtimeSettings.ContentRootReturns(tmpDir)
+		runtimeSettings.SIGOwnersReturns(owners)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	Context("when the KEP affects a subproject", func() {
+		It("suggests the subproject OWNERS and SIG technical leads, skipping entries which are not GitHub handles", func() {
+			initKEP(filepath.Join("sig-node", "kubelet", "dynamic-kubelet-configuration"))
+
+			reviewers, approvers, err := workflow.SuggestOwners(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reviewers).To(Equal([]string{"reviewerOne", "leadOne"}))
+			Expect(approvers).To(Equal([]string{"approverOne", "leadOne"}))
+
+			sig, subproject := owners.SubprojectApproversArgsForCall(0)
+			Expect(sig).To(Equal("sig-node"))
+			Expect(subproject).To(Equal("kubelet"))
+		})
+	})
+
+	Context("when the KEP is SIG wide", func() {
+		It("suggests the SIG chairs and technical leads as approvers, excluding authors", func() {
+			initKEP(filepath.Join("sig-node", "kubelet-v2-api"))
+
+			reviewers, approvers, err := workflow.SuggestOwners(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reviewers).To(Equal([]string{"leadOne"}))
+			Expect(approvers).To(Equal([]string{"chairOne", "leadOne"}))
+			Expect(owners.ChairsArgsForCall(0)).To(Equal("sig-node"))
+		})
+	})
+
+	It("assigns the suggested owners to the KEP", func() {
+		initKEP(filepath.Join("sig-node", "kubelet-v2-api"))
+
+		_, _, err := workflow.AssignOwners(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred())
+
+		kep, err := keps.Open(tmpDir, filepath.Join(tmpDir, "sig-node", "sig-wide", "kubelet-v2-api"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.Reviewers()).To(ConsistOf("leadOne"))
+		Expect(kep.Approvers()).To(ConsistOf("chairOne", "leadOne"))
+	})
+})
@@ -41,13 +41,13 @@ var _ = Describe("Plan()", func() {
 		// simulate targeting the newly created KEP
 		runtimeSettings.TargetDirReturns(targetDir)
 
-		err = workflow.Propose(runtimeSettings)
+		_, err = workflow.Propose(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
-		err = workflow.Accept(runtimeSettings)
+		_, err = workflow.Accept(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
-		err = workflow.Plan(runtimeSettings)
+		_, err = workflow.Plan(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
 		By("creating templates for content under guides/")
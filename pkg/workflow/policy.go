@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/policy"
+	"github.com/calebamiles/keps/pkg/settings"
+)
+
+// ExplainPolicy returns each check applied to the KEP at the target directory
+// and why it applies. Only the KEP metadata is read so that the policy can be
+// explained for KEPs which fail their checks
+func ExplainPolicy(runtime settings.Runtime) ([]policy.Explanation, error) {
+	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := metadata.Open(p)
+	if err != nil {
+		return nil, err
+	}
+
+	pol, err := policy.Load(runtime.ContentRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	return pol.Explain(meta.OwningSIG(), meta.State()), nil
+}
+
+// Warnings returns the problems less serious than errors found by the checks
+// of the KEP at the target directory, see keps.Instance.Warnings
+func Warnings(runtime settings.Runtime) ([]check.Finding, error) {
+	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return nil, err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return kep.Warnings(), nil
+}
@@ -0,0 +1,88 @@
+package workflow_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/check"
+	"github.com/calebamiles/keps/pkg/keps/policy"
+	"github.com/calebamiles/keps/pkg/keps/states"
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var _ = Describe("KEP policy", func() {
+	var (
+		tmpDir          string
+		kepDir          string
+		runtimeSettings *settingsfakes.FakeRuntime
+	)
+
+	writePolicy := func(contents string) {
+		err := ioutil.WriteFile(filepath.Join(tmpDir, policy.Filename), []byte(contents), os.ModePerm)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		tmpDir, kepDir, runtimeSettings = initFixtureKEP(filepath.Join("sig-node", "kubelet", "device-plugins"))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("applies the policy for the owning SIG when opening a KEP", func() {
+		writePolicy("rules:\n- sigs: [sig-node]\n  checks: [has-editors]\n")
+
+		_, err := keps.Open(tmpDir, kepDir)
+		Expect(err).To(MatchError(ContainSubstring("no editors")))
+
+		writePolicy("rules:\n- sigs: [sig-node]\n  checks: [has-editors]\n  severity: warning\n")
+
+		kep, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.Warnings()).To(ContainElement(check.Finding{Severity: check.Warning, Message: "no editors"}))
+
+		writePolicy("rules:\n- sigs: [sig-apps]\n  checks: [has-editors]\n")
+
+		_, err = keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("applies the checks for the state a KEP moves to", func() {
+		writePolicy("rules:\n- states: [provisional]\n  checks: [has-editors]\n")
+
+		kep, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.Warnings()).To(BeEmpty())
+
+		Expect(kep.SetState(states.Provisional)).To(Succeed())
+		Expect(kep.Check()).To(MatchError(ContainSubstring("no editors")))
+		Expect(kep.Warnings()).ToNot(BeEmpty(), "expected the built in checks for provisional KEPs to apply")
+	})
+
+	It("returns the warnings found by checking a KEP", func() {
+		writePolicy("rules:\n- sigs: [sig-node]\n  checks: [has-editors]\n  severity: warning\n")
+
+		warnings, err := workflow.Warnings(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(ContainElement(check.Finding{Severity: check.Warning, Message: "no editors"}))
+	})
+
+	It("explains which checks apply to a KEP and why", func() {
+		writePolicy("rules:\n- sigs: [sig-node]\n  checks: [has-editors]\n  reason: every KEP needs an editor\n")
+
+		explanations, err := workflow.ExplainPolicy(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred())
+
+		last := explanations[len(explanations)-1]
+		Expect(last.Check).To(Equal("has-editors"))
+		Expect(last.Reason).To(ContainSubstring("rule 1 (sigs: sig-node): every KEP needs an editor"))
+	})
+})
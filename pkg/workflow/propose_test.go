@@ -1,16 +1,29 @@
 package workflow_test
 
 import (
+	"encoding/json"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	libgit "gopkg.in/src-d/go-git.v4"
+	libgitplumbing "gopkg.in/src-d/go-git.v4/plumbing"
+	libgitobject "gopkg.in/src-d/go-git.v4/plumbing/object"
+
+	"github.com/calebamiles/keps/pkg/changes"
+	"github.com/calebamiles/keps/pkg/changes/auth/authfakes"
+	"github.com/calebamiles/keps/pkg/changes/github"
 	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
 	"github.com/calebamiles/keps/pkg/keps/states"
 	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+	"github.com/calebamiles/keps/pkg/sigs/sigsfakes"
 
 	"github.com/calebamiles/keps/pkg/workflow"
 )
@@ -48,15 +61,217 @@ var _ = Describe("Propose", func() {
 		runtimeSettings.TargetDirReturns(targetDir)
 
 		By("updating the KEP state and persisting the KEP")
-		err = workflow.Propose(runtimeSettings)
+		_, err = workflow.Propose(runtimeSettings)
 		Expect(err).ToNot(HaveOccurred())
 
 		By("marking the KEP as provisional")
-		kep, err := keps.Open(targetDir)
+		kep, err := keps.Open(contentRoot, targetDir)
 		Expect(err).ToNot(HaveOccurred(), "opening KEP after propose")
 
 		Expect(kep.State()).To(Equal(states.Provisional))
 
 		// check that has matching state and last updated
 	})
+
+	Context("when a GitHub token is configured", func() {
+		It("pushes the KEP to the principal's fork and opens a pull request", func() {
+			tmpDir, err := ioutil.TempDir("", "kep-propose-pr")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpDir)
+
+			workDir, originDir, err := createGitReposAt(tmpDir)
+			Expect(err).ToNot(HaveOccurred(), "creating git repositories")
+
+			contentRoot := filepath.Join(workDir, "keps")
+
+			err = createSIGDirsAt(contentRoot)
+			Expect(err).ToNot(HaveOccurred(), "creating SIG directories")
+
+			var createPrPayload struct {
+				Title string `json:"title"`
+				Body  string `json:"body"`
+				Head  string `json:"head"`
+				Base  string `json:"base"`
+			}
+
+			var requestReviewersPayload struct {
+				Reviewers []string `json:"reviewers"`
+			}
+
+			var requestedPath string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(r.Header.Get("Authorization")).To(Equal("token some-token"))
+
+				if r.URL.Path == "/repos/kubernetes/enhancements/pulls/1/requested_reviewers" {
+					Expect(json.NewDecoder(r.Body).Decode(&requestReviewersPayload)).To(Succeed())
+
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(`{"number": 1}`))
+					return
+				}
+
+				requestedPath = r.URL.Path
+				Expect(json.NewDecoder(r.Body).Decode(&createPrPayload)).To(Succeed())
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"number": 1, "html_url": "https://github.com/kubernetes/enhancements/pull/1"}`))
+			}))
+			defer server.Close()
+
+			token := &authfakes.FakeTokenProvider{}
+			token.ValueReturns("some-token", nil)
+
+			kepDirName := "value-delivered-over-multiple-releases"
+
+			runtimeSettings := &settingsfakes.FakeRuntime{}
+			runtimeSettings.PrincipalReturns(authorOne)
+			runtimeSettings.TargetDirReturns(kepDirName)
+			runtimeSettings.ContentRootReturns(contentRoot)
+			runtimeSettings.TokenReturns(token)
+			runtimeSettings.GitHubOptionsReturns(github.Options{ApiUrl: server.URL, GitUrl: tmpDir})
+			runtimeSettings.UpstreamOwnerReturns("kubernetes")
+			runtimeSettings.UpstreamRepositoryReturns("enhancements")
+
+			owners := &sigsfakes.FakeOwners{}
+			owners.ChairsReturns([]string{"chairOne", authorOne})
+			owners.TechnicalLeadsReturns([]string{"@leadOne"})
+			runtimeSettings.SIGOwnersReturns(owners)
+
+			targetDir, err := workflow.Init(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred(), "simulating `kep init`")
+
+			runtimeSettings.TargetDirReturns(targetDir)
+
+			By("opening a pull request against the upstream repository")
+			prUrl, err := workflow.Propose(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(prUrl).To(Equal("https://github.com/kubernetes/enhancements/pull/1"))
+
+			Expect(requestedPath).To(Equal("/repos/kubernetes/enhancements/pulls"))
+			Expect(createPrPayload.Title).To(Equal("Propose KEP: Value Delivered Over Multiple Releases"))
+			Expect(createPrPayload.Head).To(Equal(authorOne + ":" + filepath.Base(targetDir) + "-propose"))
+			Expect(createPrPayload.Base).To(Equal("master"))
+			Expect(createPrPayload.Body).To(ContainSubstring("@" + authorOne))
+
+			By("recording the pull request in the KEP metadata")
+			kep, err := keps.Open(contentRoot, targetDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kep.PullRequests()).To(Equal([]metadata.PullRequest{{
+				URL:     "https://github.com/kubernetes/enhancements/pull/1",
+				Number:  1,
+				Purpose: metadata.PurposePropose,
+				State:   metadata.PullRequestOpen,
+			}}))
+
+			By("requesting reviews from the owning SIG, excluding the author")
+			Expect(requestReviewersPayload.Reviewers).To(Equal([]string{"leadOne", "chairOne"}))
+
+			By("pushing a branch named after the KEP and the action to the principal's fork")
+			origin, err := libgit.PlainOpen(originDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			branch, err := origin.Reference(libgitplumbing.ReferenceName("refs/heads/"+filepath.Base(targetDir)+"-propose"), true)
+			Expect(err).ToNot(HaveOccurred(), "finding pushed branch")
+
+			commit, err := origin.CommitObject(branch.Hash())
+			Expect(err).ToNot(HaveOccurred())
+
+			files, err := commit.Files()
+			Expect(err).ToNot(HaveOccurred())
+
+			var pushedMetadata string
+			err = files.ForEach(func(f *libgitobject.File) error {
+				if strings.HasSuffix(f.Name, metadataFilename) {
+					pushedMetadata, err = f.Contents()
+					return err
+				}
+
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pushedMetadata).ToNot(BeEmpty(), "expected KEP metadata to be pushed")
+
+			By("only recording the pull request locally")
+			Expect(pushedMetadata).ToNot(ContainSubstring("https://github.com/kubernetes/enhancements/pull/1"))
+			Expect(commit.Message).To(Equal("Propose KEP: Value Delivered Over Multiple Releases"))
+
+			By("leaving the principal's clone on its own branch")
+			work, err := libgit.PlainOpen(workDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			head, err := work.Head()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(head.Name().Short()).To(Equal("master"))
+
+			By("accepting the KEP on a separate branch while the record of the proposal is uncommitted")
+			_, err = workflow.Accept(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createPrPayload.Title).To(Equal("Accept KEP: Value Delivered Over Multiple Releases"))
+			Expect(createPrPayload.Head).To(Equal(authorOne + ":" + filepath.Base(targetDir) + "-accept"))
+
+			_, err = origin.Reference(libgitplumbing.ReferenceName("refs/heads/"+filepath.Base(targetDir)+"-accept"), true)
+			Expect(err).ToNot(HaveOccurred(), "finding pushed accept branch")
+		})
+	})
+
+	Context("when proposing changes without GitHub", func() {
+		It("commits the KEP to a local branch and writes a patch series", func() {
+			tmpDir, err := ioutil.TempDir("", "kep-propose-local")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpDir)
+
+			workDir, _, err := createGitReposAt(tmpDir)
+			Expect(err).ToNot(HaveOccurred(), "creating git repositories")
+
+			contentRoot := filepath.Join(workDir, "keps")
+
+			err = createSIGDirsAt(contentRoot)
+			Expect(err).ToNot(HaveOccurred(), "creating SIG directories")
+
+			mirrorDir := filepath.Join(tmpDir, "mirror.git")
+			_, err = libgit.PlainInit(mirrorDir, true)
+			Expect(err).ToNot(HaveOccurred())
+
+			patchDir := filepath.Join(tmpDir, "patches")
+
+			runtimeSettings := &settingsfakes.FakeRuntime{}
+			runtimeSettings.PrincipalReturns(authorOne)
+			runtimeSettings.TargetDirReturns("value-delivered-over-multiple-releases")
+			runtimeSettings.ContentRootReturns(contentRoot)
+			runtimeSettings.AuthorReturns(changes.Author{Name: "Jane Doe", Email: "jane@example.com"})
+			runtimeSettings.LocalOptionsReturns(&changes.LocalOptions{PatchDir: patchDir, PushTo: mirrorDir})
+
+			targetDir, err := workflow.Init(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred(), "simulating `kep init`")
+
+			runtimeSettings.TargetDirReturns(targetDir)
+
+			reviewLocation, err := workflow.Propose(runtimeSettings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reviewLocation).To(Equal(patchDir))
+
+			By("writing a cover letter and a patch adding the KEP")
+			patches, err := filepath.Glob(filepath.Join(patchDir, "*.patch"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patches).To(HaveLen(2))
+
+			coverLetter, err := ioutil.ReadFile(filepath.Join(patchDir, "0000-cover-letter.patch"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(coverLetter)).To(ContainSubstring("Subject: [PATCH 0/1] Propose KEP: Value Delivered Over Multiple Releases"))
+
+			patch, err := ioutil.ReadFile(patches[1])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(patch)).To(ContainSubstring("From: Jane Doe <jane@example.com>"))
+			Expect(string(patch)).To(ContainSubstring(metadataFilename))
+
+			By("pushing the branch to the configured remote")
+			mirror, err := libgit.PlainOpen(mirrorDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = mirror.Reference(libgitplumbing.ReferenceName("refs/heads/"+filepath.Base(targetDir)+"-propose"), true)
+			Expect(err).ToNot(HaveOccurred(), "finding pushed branch")
+		})
+	})
 })
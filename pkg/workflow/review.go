@@ -0,0 +1,213 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/calebamiles/keps/pkg/changes"
+	"github.com/calebamiles/keps/pkg/changes/github"
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/settings"
+)
+
+// submitForReview commits the content of a KEP to a branch named after the KEP
+// and the action, e.g. `<kep>-propose`, and submits the branch for review,
+// returning a human consumable location of the review. The branch is made in a
+// temporary clone of the repository containing the KEP so the principal's own
+// clone is left as is. By default the branch is pushed to the principal's fork
+// (the `origin` remote) and a pull request is opened against the upstream
+// enhancements repository, which is recorded in the local KEP metadata. When
+// the runtime has LocalOptions no GitHub API is used, the commits are instead
+// written as a patch series. When neither a GitHub token nor LocalOptions are
+// configured the KEP is only changed locally and an empty location is returned
+func submitForReview(runtime settings.Runtime, kep keps.Instance, action string) (string, error) {
+	token := runtime.Token()
+	localOptions := runtime.LocalOptions()
+	if token == nil && localOptions == nil {
+		return "", nil
+	}
+
+	repoRoot, err := findRepositoryRoot(kep.ContentDir())
+	if err != nil {
+		return "", err
+	}
+
+	kepLocation, err := filepath.Rel(repoRoot, kep.ContentDir())
+	if err != nil {
+		return "", err
+	}
+
+	handle := strings.TrimPrefix(runtime.Principal(), "@")
+	title := fmt.Sprintf("%s KEP: %s", action, kep.Title())
+	branchName := fmt.Sprintf("%s-%s", filepath.Base(kep.ContentDir()), strings.ToLower(action))
+
+	author := runtime.Author()
+	if author.Name == "" || author.Email == "" {
+		author.Name = handle
+		author.Email = fmt.Sprintf("%s@users.noreply.github.com", handle)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "kep-review")
+	if err != nil {
+		return "", err
+	}
+
+	defer os.RemoveAll(tmpDir)
+
+	workPath := filepath.Join(tmpDir, filepath.Base(repoRoot))
+
+	var client *github.Client
+	var review changes.Reviewable
+	switch {
+	case localOptions != nil:
+		review, err = changes.LocalCopy(author, repoRoot, workPath, branchName, *localOptions)
+	default:
+		upstream := changes.Upstream{Owner: runtime.UpstreamOwner(), Repository: runtime.UpstreamRepository()}
+		client = github.NewClient(token, runtime.GitHubOptions())
+
+		review, err = changes.Copy(client, handle, author, upstream, repoRoot, workPath, branchName)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	err = review.Add(kep.ContentDir(), kepLocation)
+	if err != nil {
+		return "", err
+	}
+
+	err = review.Commit(title)
+	if err != nil {
+		return "", err
+	}
+
+	description := reviewDescription(kep, kepLocation)
+
+	location, err := review.Submit(title, description)
+	if err != nil {
+		return "", err
+	}
+
+	if localOptions != nil {
+		return location, nil
+	}
+
+	// the pull request number is only known once the pull request is open, so
+	// it is only recorded locally and submitted along with the next change
+	err = recordPullRequest(kep, location, metadata.PullRequestPurpose(strings.ToLower(action)))
+	if err != nil {
+		return "", err
+	}
+
+	err = requestReviews(client, runtime, kep, location)
+	if err != nil {
+		// the pull request is open, reviewers can still be requested by hand
+		log.Warnf("requesting reviews of %s: %s", location, err)
+	}
+
+	return location, nil
+}
+
+// requestReviews requests reviews of the pull request at prUrl from the KEP's
+// reviewers and approvers along with those suggested by the owning SIG. GitHub
+// refuses review requests from the pull request author so neither the authors
+// nor the principal are requested
+func requestReviews(client *github.Client, runtime settings.Runtime, kep keps.Instance, prUrl string) error {
+	suggestedReviewers, suggestedApprovers := suggestOwners(runtime.SIGOwners(), kep)
+
+	excluded := []string{runtime.Principal()}
+	excluded = append(excluded, kep.Authors()...)
+
+	requested := newHandleSet(excluded)
+	requested.add(kep.Reviewers()...)
+	requested.add(kep.Approvers()...)
+	requested.add(suggestedReviewers...)
+	requested.add(suggestedApprovers...)
+
+	if len(requested.handles) == 0 {
+		return nil
+	}
+
+	owner, repo, number, err := github.ParsePullRequestUrl(prUrl)
+	if err != nil {
+		return err
+	}
+
+	return client.RequestReviewers(context.Background(), owner, repo, number, requested.handles...)
+}
+
+// recordPullRequest associates the open pull request at prUrl with the KEP and
+// persists the updated metadata
+func recordPullRequest(kep keps.Instance, prUrl string, purpose metadata.PullRequestPurpose) error {
+	_, _, number, err := github.ParsePullRequestUrl(prUrl)
+	if err != nil {
+		return err
+	}
+
+	kep.AddPullRequest(metadata.PullRequest{
+		URL:     prUrl,
+		Number:  number,
+		Purpose: purpose,
+		State:   metadata.PullRequestOpen,
+	})
+
+	kep.AddEvent(fmt.Sprintf("%s pull request #%d opened: %s", purpose, number, prUrl))
+
+	return kep.Persist()
+}
+
+func reviewDescription(kep keps.Instance, kepLocation string) string {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "These changes were proposed by the KEP tool.\n\n")
+	fmt.Fprintf(&b, "- **Title:** %s\n", kep.Title())
+	fmt.Fprintf(&b, "- **Owning SIG:** %s\n", kep.OwningSIG())
+	fmt.Fprintf(&b, "- **State:** %s\n", kep.State())
+	fmt.Fprintf(&b, "- **Location:** `%s`\n", filepath.ToSlash(kepLocation))
+
+	authors := []string{}
+	for _, author := range kep.Authors() {
+		identity := metadata.ParseIdentity(author)
+		if identity.Handle == "" {
+			authors = append(authors, identity.Name)
+			continue
+		}
+
+		authors = append(authors, "@"+identity.Handle)
+	}
+
+	fmt.Fprintf(&b, "- **Authors:** %s\n", strings.Join(authors, ", "))
+
+	return b.String()
+}
+
+// findRepositoryRoot walks up from p until a directory containing `.git` is found
+func findRepositoryRoot(p string) (string, error) {
+	dir, err := filepath.Abs(p)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		_, err := os.Stat(filepath.Join(dir, ".git"))
+		if err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("could not find a git repository containing: %s", p)
+		}
+
+		dir = parent
+	}
+}
@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/settings"
+	"github.com/calebamiles/keps/pkg/sigs"
+)
+
+// UpdateRouting replaces the SIGs participating in a KEP and the subprojects
+// it affects, a nil slice leaves the existing value alone while an empty slice
+// clears it. Both are validated against the known SIGs, see sigs.CheckRouting,
+// and the KEP checks are run again before it is persisted. The owning SIG and
+// location of a KEP are left alone
+func UpdateRouting(runtime settings.Runtime, participatingSIGs []string, subprojects []string) error {
+	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), p)
+	if err != nil {
+		return err
+	}
+
+	if participatingSIGs == nil {
+		participatingSIGs = kep.ParticipatingSIGs()
+	}
+
+	if subprojects == nil {
+		subprojects = kep.AffectedSubprojects()
+	}
+
+	err = sigs.CheckRouting(kep.OwningSIG(), participatingSIGs, subprojects)
+	if err != nil {
+		return err
+	}
+
+	kep.SetParticipatingSIGs(participatingSIGs...)
+	kep.SetAffectedSubprojects(subprojects...)
+
+	err = kep.Check()
+	if err != nil {
+		return err
+	}
+
+	return kep.Persist()
+}
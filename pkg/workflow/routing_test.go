@@ -0,0 +1,69 @@
+package workflow_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var _ = Describe("routing a KEP to SIGs and subprojects", func() {
+	var (
+		tmpDir          string
+		runtimeSettings *settingsfakes.FakeRuntime
+	)
+
+	BeforeEach(func() {
+		tmpDir, runtimeSettings = newFixtureRuntime(filepath.Join("sig-node", "kubelet", "dynamic-kubelet-configuration"))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("records participating SIGs and additional subprojects at init", func() {
+		kepDir, err := workflow.InitWithRouting(runtimeSettings, []string{"sig-storage"}, []string{"kubernetes-csi"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kepDir).To(Equal(filepath.Join(tmpDir, "sig-node", "kubelet", "dynamic-kubelet-configuration")))
+
+		kep, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.ParticipatingSIGs()).To(Equal([]string{"sig-storage"}))
+		Expect(kep.AffectedSubprojects()).To(Equal([]string{"kubelet", "kubernetes-csi"}))
+
+		By("refusing routing which does not match the known SIGs")
+		runtimeSettings.TargetDirReturns(filepath.Join("sig-node", "another-kep"))
+		_, err = workflow.InitWithRouting(runtimeSettings, []string{"sig-not-real"}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("updates the routing of an existing KEP", func() {
+		kepDir, err := workflow.Init(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred())
+
+		runtimeSettings.TargetDirReturns(kepDir)
+
+		Expect(workflow.UpdateRouting(runtimeSettings, []string{"sig-storage", "wg-policy"}, nil)).To(Succeed())
+
+		kep, err := keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.ParticipatingSIGs()).To(Equal([]string{"sig-storage", "wg-policy"}))
+		Expect(kep.AffectedSubprojects()).To(Equal([]string{"kubelet"}), "a nil slice leaves the subprojects alone")
+
+		Expect(workflow.UpdateRouting(runtimeSettings, []string{}, []string{"kubelet", "cri-tools"})).To(Succeed())
+
+		kep, err = keps.Open(tmpDir, kepDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.ParticipatingSIGs()).To(BeEmpty())
+		Expect(kep.AffectedSubprojects()).To(Equal([]string{"kubelet", "cri-tools"}))
+
+		By("refusing subprojects of SIGs not involved in the KEP")
+		Expect(workflow.UpdateRouting(runtimeSettings, nil, []string{"kubernetes-csi"})).ToNot(Succeed())
+	})
+})
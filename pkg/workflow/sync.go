@@ -0,0 +1,145 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/calebamiles/keps/pkg/changes/github"
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/states"
+	"github.com/calebamiles/keps/pkg/settings"
+)
+
+// stateAfterMerge is the state a KEP reaches once a pull request with a given
+// purpose merges. A merged accept pull request means the sponsoring SIG has
+// accepted the KEP, which may then be implemented. Pull requests opened by
+// Propose, Plan and Approve already carry the state they were opened to reach
+// so are not listed
+var stateAfterMerge = map[metadata.PullRequestPurpose]states.Name{
+	metadata.PurposeAccept:         states.Implementable,
+	metadata.PurposeImplementation: states.Implemented,
+}
+
+// Sync queries GitHub for the status of each open pull request associated with
+// a KEP, recording pull requests which have merged or closed in the KEP's event
+// log. When updateState is set a merged pull request also moves the KEP to the
+// state implied by the purpose of the pull request (e.g. a merged accept pull
+// request makes the KEP implementable and a merged implementation pull request,
+// see Track, makes it implemented). The events added are returned
+func Sync(runtime settings.Runtime, updateState bool) ([]metadata.Event, error) {
+	token := runtime.Token()
+	if token == nil {
+		return nil, errors.New("a GitHub token is required to sync pull requests")
+	}
+
+	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return nil, err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	client := github.NewClient(token, runtime.GitHubOptions())
+	eventsBefore := len(kep.Events())
+
+	for _, pr := range kep.PullRequests() {
+		if pr.State != metadata.PullRequestOpen {
+			continue
+		}
+
+		owner, repo, number, err := github.ParsePullRequestUrl(pr.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		current, err := client.GetPR(context.Background(), owner, repo, number)
+		if err != nil {
+			return nil, err
+		}
+
+		state := pullRequestState(current)
+		if state == pr.State {
+			continue
+		}
+
+		pr.State = state
+		kep.AddPullRequest(pr)
+		kep.AddEvent(fmt.Sprintf("%s pull request #%d %s: %s", pr.Purpose, pr.Number, state, pr.URL))
+
+		if !updateState || state != metadata.PullRequestMerged {
+			continue
+		}
+
+		next, ok := stateAfterMerge[pr.Purpose]
+		if !ok || kep.State() == next {
+			continue
+		}
+
+		previous := kep.State()
+
+		err = kep.SetState(next)
+		if err != nil {
+			return nil, err
+		}
+
+		kep.AddEvent(fmt.Sprintf("state changed from %s to %s after #%d merged", previous, next, pr.Number))
+	}
+
+	events := kep.Events()
+	if len(events) == eventsBefore {
+		return nil, nil
+	}
+
+	err = kep.Persist()
+	if err != nil {
+		return nil, err
+	}
+
+	return events[eventsBefore:], nil
+}
+
+func pullRequestState(pr *github.PullRequest) metadata.PullRequestState {
+	switch {
+	case pr.Merged:
+		return metadata.PullRequestMerged
+	case pr.State == "closed":
+		return metadata.PullRequestClosed
+	default:
+		return metadata.PullRequestOpen
+	}
+}
+
+// Track associates the pull request at prUrl, which implements the KEP, with
+// the KEP so that Sync follows its status
+func Track(runtime settings.Runtime, prUrl string) error {
+	_, _, number, err := github.ParsePullRequestUrl(prUrl)
+	if err != nil {
+		return err
+	}
+
+	p, err := keps.Path(runtime.ContentRoot(), runtime.TargetDir())
+	if err != nil {
+		return err
+	}
+
+	kep, err := keps.Open(runtime.ContentRoot(), p)
+	if err != nil {
+		return err
+	}
+
+	kep.AddPullRequest(metadata.PullRequest{
+		URL:     prUrl,
+		Number:  number,
+		Purpose: metadata.PurposeImplementation,
+		State:   metadata.PullRequestOpen,
+	})
+
+	kep.AddEvent(fmt.Sprintf("%s pull request #%d tracked: %s", metadata.PurposeImplementation, number, prUrl))
+
+	return kep.Persist()
+}
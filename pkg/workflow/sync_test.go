@@ -0,0 +1,161 @@
+package workflow_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/changes/auth/authfakes"
+	"github.com/calebamiles/keps/pkg/changes/github"
+	"github.com/calebamiles/keps/pkg/keps"
+	"github.com/calebamiles/keps/pkg/keps/metadata"
+	"github.com/calebamiles/keps/pkg/keps/states"
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+
+	"github.com/calebamiles/keps/pkg/workflow"
+)
+
+var _ = Describe("Sync", func() {
+	const (
+		authorOne = "handleOne"
+		prUrl     = "https://github.com/kubernetes/enhancements/pull/2"
+	)
+
+	var (
+		tmpDir          string
+		contentRoot     string
+		targetDir       string
+		server          *httptest.Server
+		requestedPaths  []string
+		runtimeSettings *settingsfakes.FakeRuntime
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "kep-sync")
+		Expect(err).ToNot(HaveOccurred())
+
+		contentRoot = filepath.Join(tmpDir, "content")
+		Expect(createSIGDirsAt(contentRoot)).To(Succeed(), "creating SIG directories")
+
+		requestedPaths = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestedPaths = append(requestedPaths, r.URL.Path)
+			w.Write([]byte(`{"number": 2, "state": "closed", "merged": true}`))
+		}))
+
+		token := &authfakes.FakeTokenProvider{}
+		token.ValueReturns("some-token", nil)
+
+		runtimeSettings = &settingsfakes.FakeRuntime{}
+		runtimeSettings.PrincipalReturns(authorOne)
+		runtimeSettings.TargetDirReturns("value-delivered-over-multiple-releases")
+		runtimeSettings.ContentRootReturns(contentRoot)
+		runtimeSettings.TokenReturns(token)
+		runtimeSettings.GitHubOptionsReturns(github.Options{ApiUrl: server.URL})
+
+		targetDir, err = workflow.Init(runtimeSettings)
+		Expect(err).ToNot(HaveOccurred(), "simulating `kep init`")
+
+		runtimeSettings.TargetDirReturns(targetDir)
+
+		kep, err := keps.Open(contentRoot, targetDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		kep.AddPullRequest(metadata.PullRequest{URL: prUrl, Number: 2, Purpose: metadata.PurposeAccept, State: metadata.PullRequestOpen})
+		Expect(kep.Persist()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(tmpDir)
+	})
+
+	It("records merged pull requests in the event log", func() {
+		events, err := workflow.Sync(runtimeSettings, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Description).To(Equal("accept pull request #2 merged: " + prUrl))
+
+		Expect(requestedPaths).To(Equal([]string{"/repos/kubernetes/enhancements/pulls/2"}))
+
+		kep, err := keps.Open(contentRoot, targetDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.PullRequests()[0].State).To(Equal(metadata.PullRequestMerged))
+		Expect(kep.Events()).To(HaveLen(1))
+		Expect(kep.State()).To(Equal(states.Draft), "expected the KEP state to be left alone")
+
+		By("skipping pull requests which are no longer open")
+		events, err = workflow.Sync(runtimeSettings, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(BeEmpty())
+		Expect(requestedPaths).To(HaveLen(1))
+	})
+
+	It("marks the KEP implementable once the accept pull request merges", func() {
+		kep, err := keps.Open(contentRoot, targetDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		kep.AddReviewers("reviewerOne")
+		kep.AddApprovers("approverOne")
+		Expect(kep.SetState(states.Provisional)).To(Succeed(), "simulating an accepted KEP")
+		Expect(kep.Persist()).To(Succeed())
+
+		events, err := workflow.Sync(runtimeSettings, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(2))
+		Expect(events[1].Description).To(Equal("state changed from provisional to implementable after #2 merged"))
+
+		kep, err = keps.Open(contentRoot, targetDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.State()).To(Equal(states.Implementable))
+	})
+
+	It("marks the KEP implemented once a tracked implementation pull request merges", func() {
+		implementationUrl := "https://github.com/kubernetes/kubernetes/pull/3"
+
+		kep, err := keps.Open(contentRoot, targetDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		kep.AddReviewers("reviewerOne")
+		kep.AddApprovers("approverOne")
+		Expect(kep.SetState(states.Implementable)).To(Succeed(), "simulating an approved KEP")
+		Expect(kep.Persist()).To(Succeed())
+
+		err = workflow.Track(runtimeSettings, implementationUrl)
+		Expect(err).ToNot(HaveOccurred())
+
+		kep, err = keps.Open(contentRoot, targetDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.PullRequests()).To(ContainElement(metadata.PullRequest{
+			URL:     implementationUrl,
+			Number:  3,
+			Purpose: metadata.PurposeImplementation,
+			State:   metadata.PullRequestOpen,
+		}))
+
+		events, err := workflow.Sync(runtimeSettings, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+		Expect(events[1].Description).To(Equal("implementation pull request #3 merged: " + implementationUrl))
+		Expect(events[2].Description).To(Equal("state changed from implementable to implemented after #3 merged"))
+
+		Expect(requestedPaths).To(ContainElement("/repos/kubernetes/kubernetes/pulls/3"))
+
+		kep, err = keps.Open(contentRoot, targetDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kep.State()).To(Equal(states.Implemented))
+	})
+
+	It("requires a GitHub token", func() {
+		runtimeSettings.TokenReturns(nil)
+
+		_, err := workflow.Sync(runtimeSettings, false)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,13 +1,47 @@
 package workflow_test
 
 import (
+	"io/ioutil"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"github.com/calebamiles/keps/pkg/settings/settingsfakes"
+	"github.com/calebamiles/keps/pkg/workflow"
 )
 
 func TestWorkflow(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Workflow Suite")
 }
+
+// fixtureAuthor is the principal of the runtimes returned by newFixtureRuntime
+const fixtureAuthor = "handleOne"
+
+// newFixtureRuntime returns a temporary content root along with a runtime
+// acting for fixtureAuthor on target within it
+func newFixtureRuntime(target string) (string, *settingsfakes.FakeRuntime) {
+	tmpDir, err := ioutil.TempDir("", "kep-workflow")
+	Expect(err).ToNot(HaveOccurred())
+
+	runtimeSettings := &settingsfakes.FakeRuntime{}
+	runtimeSettings.PrincipalReturns(fixtureAuthor)
+	runtimeSettings.ContentRootReturns(tmpDir)
+	runtimeSettings.TargetDirReturns(target)
+
+	return tmpDir, runtimeSettings
+}
+
+// initFixtureKEP returns a temporary content root holding a KEP created by
+// `kep init` at target, the KEP directory and a runtime targeting the KEP
+func initFixtureKEP(target string) (string, string, *settingsfakes.FakeRuntime) {
+	tmpDir, runtimeSettings := newFixtureRuntime(target)
+
+	kepDir, err := workflow.Init(runtimeSettings)
+	Expect(err).ToNot(HaveOccurred(), "simulating `kep init`")
+
+	runtimeSettings.TargetDirReturns(kepDir)
+
+	return tmpDir, kepDir, runtimeSettings
+}